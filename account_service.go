@@ -47,3 +47,13 @@ type Balance struct {
 	Free   string `json:"free"`
 	Locked string `json:"locked"`
 }
+
+// FreeDecimal return free as a decimal, zero if it is empty or malformed
+func (b *Balance) FreeDecimal() Decimal {
+	return parseDecimalOrZero(b.Free)
+}
+
+// LockedDecimal return locked as a decimal, zero if it is empty or malformed
+func (b *Balance) LockedDecimal() Decimal {
+	return parseDecimalOrZero(b.Locked)
+}
@@ -0,0 +1,207 @@
+package binance
+
+import (
+	"sort"
+)
+
+// bookRatioPlaces is the number of decimals of ratios like spreads in bps
+// and imbalance
+const bookRatioPlaces = 8
+
+var (
+	decimalTwo         = NewDecimal(2, 0)
+	decimalHundred     = NewDecimal(100, 0)
+	decimalBasisPoints = NewDecimal(10000, 0)
+)
+
+// BookAnalytics compute pre-trade metrics on a snapshot of bids and asks,
+// like DepthResponse, WsPartialBookDepthEvent or an OrderBook
+type BookAnalytics struct {
+	bids []OrderBookLevel // by descending price
+	asks []OrderBookLevel // by ascending price
+}
+
+// BookDepth define the liquidity of both sides within a price range
+type BookDepth struct {
+	BidQuantity Decimal
+	AskQuantity Decimal
+	// BidNotional and AskNotional are the quote amounts of the quantities
+	BidNotional Decimal
+	AskNotional Decimal
+}
+
+// FillEstimate define the expected execution of a market order walking the book
+type FillEstimate struct {
+	Side SideType
+	// Quantity is the requested quantity, Filled is less when the book is too thin
+	Quantity Decimal
+	Filled   Decimal
+	Notional Decimal
+	AvgPrice Decimal
+	// BestPrice is the first level consumed and WorstPrice the last one
+	BestPrice  Decimal
+	WorstPrice Decimal
+	// SlippageBps is the distance between AvgPrice and BestPrice in basis points
+	SlippageBps Decimal
+}
+
+// Complete check if the book has enough liquidity for the whole quantity
+func (e *FillEstimate) Complete() bool {
+	return !e.Filled.LessThan(e.Quantity)
+}
+
+// NewBookAnalytics init analytics on bids and asks, levels are sorted and
+// the ones without quantity are ignored
+func NewBookAnalytics(bids []Bid, asks []Ask) *BookAnalytics {
+	a := &BookAnalytics{
+		bids: make([]OrderBookLevel, 0, len(bids)),
+		asks: make([]OrderBookLevel, 0, len(asks)),
+	}
+	for _, b := range bids {
+		if q := b.QuantityDecimal(); q.Sign() > 0 {
+			a.bids = append(a.bids, OrderBookLevel{Price: b.PriceDecimal(), Quantity: q})
+		}
+	}
+	for _, ask := range asks {
+		if q := ask.QuantityDecimal(); q.Sign() > 0 {
+			a.asks = append(a.asks, OrderBookLevel{Price: ask.PriceDecimal(), Quantity: q})
+		}
+	}
+	sort.SliceStable(a.bids, func(i, j int) bool {
+		return a.bids[i].Price.GreaterThan(a.bids[j].Price)
+	})
+	sort.SliceStable(a.asks, func(i, j int) bool {
+		return a.asks[i].Price.LessThan(a.asks[j].Price)
+	})
+	return a
+}
+
+// Analytics return analytics on a copy of the current book, both sides are
+// copied from the same update
+func (ob *OrderBook) Analytics() *BookAnalytics {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+	return &BookAnalytics{bids: copyOrderBookLevels(ob.bids, 0), asks: copyOrderBookLevels(ob.asks, 0)}
+}
+
+// Mid return the middle of the best bid and ask, false if a side is empty
+func (a *BookAnalytics) Mid() (Decimal, bool) {
+	if len(a.bids) == 0 || len(a.asks) == 0 {
+		return Decimal{}, false
+	}
+	bid, ask := a.bids[0].Price, a.asks[0].Price
+	scale := bid.Scale()
+	if ask.Scale() > scale {
+		scale = ask.Scale()
+	}
+	return bid.Add(ask).Div(decimalTwo, scale+1), true
+}
+
+// Spread return the best ask minus the best bid, false if a side is empty
+func (a *BookAnalytics) Spread() (Decimal, bool) {
+	if len(a.bids) == 0 || len(a.asks) == 0 {
+		return Decimal{}, false
+	}
+	return a.asks[0].Price.Sub(a.bids[0].Price), true
+}
+
+// SpreadBps return the spread in basis points of the mid, false if a side
+// is empty or the mid is zero
+func (a *BookAnalytics) SpreadBps() (Decimal, bool) {
+	spread, ok := a.Spread()
+	mid, _ := a.Mid()
+	if !ok || mid.IsZero() {
+		return Decimal{}, false
+	}
+	return spread.Mul(decimalBasisPoints).Div(mid, bookRatioPlaces), true
+}
+
+// DepthWithin return the cumulative bids and asks priced within pct percent
+// of the mid, like 1 for 1%. It is zero if a side is empty.
+func (a *BookAnalytics) DepthWithin(pct Decimal) *BookDepth {
+	depth := new(BookDepth)
+	mid, ok := a.Mid()
+	if !ok {
+		return depth
+	}
+	offset := mid.Mul(pct)
+	offset = offset.Div(decimalHundred, offset.Scale()+2)
+	low, high := mid.Sub(offset), mid.Add(offset)
+	for _, b := range a.bids {
+		if b.Price.LessThan(low) {
+			break
+		}
+		depth.BidQuantity = depth.BidQuantity.Add(b.Quantity)
+		depth.BidNotional = depth.BidNotional.Add(b.Price.Mul(b.Quantity))
+	}
+	for _, ask := range a.asks {
+		if ask.Price.GreaterThan(high) {
+			break
+		}
+		depth.AskQuantity = depth.AskQuantity.Add(ask.Quantity)
+		depth.AskNotional = depth.AskNotional.Add(ask.Price.Mul(ask.Quantity))
+	}
+	return depth
+}
+
+// EstimateFill walk the asks for a buy or the bids for a sell of quantity
+// and return the expected average price and slippage
+func (a *BookAnalytics) EstimateFill(side SideType, quantity Decimal) *FillEstimate {
+	e := &FillEstimate{Side: side, Quantity: quantity}
+	levels := a.asks
+	if side == SideTypeSell {
+		levels = a.bids
+	}
+	scale := int32(0)
+	for _, l := range levels {
+		remaining := quantity.Sub(e.Filled)
+		if remaining.Sign() <= 0 {
+			break
+		}
+		take := l.Quantity
+		if remaining.LessThan(take) {
+			take = remaining
+		}
+		if e.Filled.IsZero() {
+			e.BestPrice = l.Price
+		}
+		if l.Price.Scale() > scale {
+			scale = l.Price.Scale()
+		}
+		e.WorstPrice = l.Price
+		e.Filled = e.Filled.Add(take)
+		e.Notional = e.Notional.Add(l.Price.Mul(take))
+	}
+	if e.Filled.IsZero() {
+		return e
+	}
+	e.AvgPrice = e.Notional.Div(e.Filled, scale+avgPriceExtraPlaces)
+	if !e.BestPrice.IsZero() {
+		e.SlippageBps = e.AvgPrice.Sub(e.BestPrice).Abs().Mul(decimalBasisPoints).Div(e.BestPrice, bookRatioPlaces)
+	}
+	return e
+}
+
+// Imbalance return (bids - asks) / (bids + asks) of the quantities of the
+// best levels of each side, all of them if levels <= 0. It ranges from -1
+// when there are only asks to 1 when there are only bids.
+func (a *BookAnalytics) Imbalance(levels int) Decimal {
+	bidQuantity := sumOrderBookLevels(a.bids, levels)
+	askQuantity := sumOrderBookLevels(a.asks, levels)
+	total := bidQuantity.Add(askQuantity)
+	if total.IsZero() {
+		return Decimal{}
+	}
+	return bidQuantity.Sub(askQuantity).Div(total, bookRatioPlaces)
+}
+
+func sumOrderBookLevels(levels []OrderBookLevel, n int) Decimal {
+	if n <= 0 || n > len(levels) {
+		n = len(levels)
+	}
+	sum := Decimal{}
+	for _, l := range levels[:n] {
+		sum = sum.Add(l.Quantity)
+	}
+	return sum
+}
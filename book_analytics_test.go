@@ -0,0 +1,103 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type bookAnalyticsTestSuite struct {
+	baseTestSuite
+	analytics *BookAnalytics
+}
+
+func TestBookAnalytics(t *testing.T) {
+	suite.Run(t, new(bookAnalyticsTestSuite))
+}
+
+func (s *bookAnalyticsTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.analytics = NewBookAnalytics(
+		[]Bid{{"99.5", "2"}, {"100", "1"}, {"101", "0"}, {"99", "3"}},
+		[]Ask{{"101", "1"}, {"103", "4"}, {"102", "2"}},
+	)
+}
+
+func (s *bookAnalyticsTestSuite) assertDecimal(e string, a Decimal) {
+	s.r().True(MustParseDecimal(e).Equal(a), "expected %s, got %s", e, a)
+}
+
+func (s *bookAnalyticsTestSuite) TestSpread() {
+	r := s.r()
+	mid, ok := s.analytics.Mid()
+	r.True(ok)
+	r.Equal("100.5", mid.String())
+	spread, ok := s.analytics.Spread()
+	r.True(ok)
+	s.assertDecimal("1", spread)
+	bps, ok := s.analytics.SpreadBps()
+	r.True(ok)
+	s.assertDecimal("99.50248756", bps)
+
+	empty := NewBookAnalytics([]Bid{{"100", "1"}}, nil)
+	_, ok = empty.Mid()
+	r.False(ok)
+	_, ok = empty.SpreadBps()
+	r.False(ok)
+}
+
+func (s *bookAnalyticsTestSuite) TestDepthWithin() {
+	depth := s.analytics.DepthWithin(MustParseDecimal("1"))
+	s.assertDecimal("3", depth.BidQuantity)
+	s.assertDecimal("299", depth.BidNotional)
+	s.assertDecimal("1", depth.AskQuantity)
+	s.assertDecimal("101", depth.AskNotional)
+
+	depth = s.analytics.DepthWithin(MustParseDecimal("5"))
+	s.assertDecimal("6", depth.BidQuantity)
+	s.assertDecimal("7", depth.AskQuantity)
+}
+
+func (s *bookAnalyticsTestSuite) TestEstimateFill() {
+	r := s.r()
+	e := s.analytics.EstimateFill(SideTypeBuy, MustParseDecimal("2.5"))
+	r.True(e.Complete())
+	s.assertDecimal("2.5", e.Filled)
+	s.assertDecimal("254", e.Notional)
+	s.assertDecimal("101.6", e.AvgPrice)
+	s.assertDecimal("101", e.BestPrice)
+	s.assertDecimal("102", e.WorstPrice)
+	s.assertDecimal("59.40594059", e.SlippageBps)
+
+	e = s.analytics.EstimateFill(SideTypeSell, MustParseDecimal("1"))
+	r.True(e.Complete())
+	s.assertDecimal("100", e.AvgPrice)
+	r.True(e.SlippageBps.IsZero())
+
+	e = s.analytics.EstimateFill(SideTypeBuy, MustParseDecimal("10"))
+	r.False(e.Complete())
+	s.assertDecimal("7", e.Filled)
+	s.assertDecimal("103", e.WorstPrice)
+
+	e = NewBookAnalytics(nil, nil).EstimateFill(SideTypeSell, MustParseDecimal("1"))
+	r.False(e.Complete())
+	r.True(e.AvgPrice.IsZero())
+}
+
+func (s *bookAnalyticsTestSuite) TestImbalance() {
+	r := s.r()
+	r.True(s.analytics.Imbalance(2).IsZero())
+	s.assertDecimal("-0.07692308", s.analytics.Imbalance(0))
+	s.assertDecimal("1", NewBookAnalytics([]Bid{{"1", "1"}}, nil).Imbalance(1))
+	r.True(NewBookAnalytics(nil, nil).Imbalance(5).IsZero())
+}
+
+func (s *bookAnalyticsTestSuite) TestOrderBook() {
+	s.mockDoOnce([]byte(orderBookSnapshotJSON), nil, http.StatusOK)
+	book := s.client.NewOrderBook("LTCBTC")
+	s.r().NoError(book.Sync(newContext()))
+	mid, ok := book.Analytics().Mid()
+	s.r().True(ok)
+	s.assertDecimal("0.00245", mid)
+}
@@ -0,0 +1,302 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CancelReplaceModeType define the behavior of cancelReplace when the cancel fails
+type CancelReplaceModeType string
+
+// CancelReplaceResultType define the result of each step of cancelReplace
+type CancelReplaceResultType string
+
+// Cancel replace enums
+const (
+	// CancelReplaceModeStopOnFailure does not place the new order if the cancel fails
+	CancelReplaceModeStopOnFailure CancelReplaceModeType = "STOP_ON_FAILURE"
+	// CancelReplaceModeAllowFailure places the new order even if the cancel fails
+	CancelReplaceModeAllowFailure CancelReplaceModeType = "ALLOW_FAILURE"
+
+	CancelReplaceResultSuccess      CancelReplaceResultType = "SUCCESS"
+	CancelReplaceResultFailure      CancelReplaceResultType = "FAILURE"
+	CancelReplaceResultNotAttempted CancelReplaceResultType = "NOT_ATTEMPTED"
+)
+
+// CancelReplaceService cancel an order and place a new order on the same symbol
+type CancelReplaceService struct {
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	cancelReplaceMode       CancelReplaceModeType
+	timeInForce             *TimeInForce
+	quantity                *string
+	quoteOrderQuantity      *string
+	price                   *string
+	cancelNewClientOrderID  *string
+	cancelOrigClientOrderID *string
+	cancelOrderID           *int64
+	newClientOrderID        *string
+	stopPrice               *string
+	icebergQuantity         *string
+	newOrderRespType        *NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *CancelReplaceService) Symbol(symbol string) *CancelReplaceService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CancelReplaceService) Side(side SideType) *CancelReplaceService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *CancelReplaceService) Type(orderType OrderType) *CancelReplaceService {
+	s.orderType = orderType
+	return s
+}
+
+// CancelReplaceMode set cancelReplaceMode
+func (s *CancelReplaceService) CancelReplaceMode(cancelReplaceMode CancelReplaceModeType) *CancelReplaceService {
+	s.cancelReplaceMode = cancelReplaceMode
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CancelReplaceService) TimeInForce(timeInForce TimeInForce) *CancelReplaceService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CancelReplaceService) Quantity(quantity string) *CancelReplaceService {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQuantity set quoteOrderQuantity
+func (s *CancelReplaceService) QuoteOrderQuantity(quoteOrderQuantity string) *CancelReplaceService {
+	s.quoteOrderQuantity = &quoteOrderQuantity
+	return s
+}
+
+// Price set price
+func (s *CancelReplaceService) Price(price string) *CancelReplaceService {
+	s.price = &price
+	return s
+}
+
+// CancelNewClientOrderID set cancelNewClientOrderID
+func (s *CancelReplaceService) CancelNewClientOrderID(cancelNewClientOrderID string) *CancelReplaceService {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return s
+}
+
+// CancelOrigClientOrderID set cancelOrigClientOrderID
+func (s *CancelReplaceService) CancelOrigClientOrderID(cancelOrigClientOrderID string) *CancelReplaceService {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return s
+}
+
+// CancelOrderID set cancelOrderID
+func (s *CancelReplaceService) CancelOrderID(cancelOrderID int64) *CancelReplaceService {
+	s.cancelOrderID = &cancelOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CancelReplaceService) NewClientOrderID(newClientOrderID string) *CancelReplaceService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CancelReplaceService) StopPrice(stopPrice string) *CancelReplaceService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// IcebergQuantity set icebergQuantity
+func (s *CancelReplaceService) IcebergQuantity(icebergQuantity string) *CancelReplaceService {
+	s.icebergQuantity = &icebergQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CancelReplaceService) NewOrderRespType(newOrderRespType NewOrderRespType) *CancelReplaceService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request. When the cancel or the new order fails the error is a
+// *CancelReplaceError holding the result of both steps.
+func (s *CancelReplaceService) Do(ctx context.Context, opts ...RequestOption) (res *CancelReplaceResponse, err error) {
+	r := &request{
+		method:   "POST",
+		endpoint: "/api/v3/order/cancelReplace",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":            s.symbol,
+		"side":              s.side,
+		"type":              s.orderType,
+		"cancelReplaceMode": s.cancelReplaceMode,
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQuantity != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQuantity
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.cancelNewClientOrderID != nil {
+		m["cancelNewClientOrderId"] = *s.cancelNewClientOrderID
+	}
+	if s.cancelOrigClientOrderID != nil {
+		m["cancelOrigClientOrderId"] = *s.cancelOrigClientOrderID
+	}
+	if s.cancelOrderID != nil {
+		m["cancelOrderId"] = *s.cancelOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.icebergQuantity != nil {
+		m["icebergQty"] = *s.icebergQuantity
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, newCancelReplaceError(err)
+	}
+	res = new(CancelReplaceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// CancelReplaceResponse define the result of the cancel and of the new
+// order. A failed step has its error set instead of its response.
+type CancelReplaceResponse struct {
+	CancelResult     CancelReplaceResultType
+	NewOrderResult   CancelReplaceResultType
+	CancelResponse   *CancelOrderResponse
+	CancelError      *APIError
+	NewOrderResponse *CreateOrderResponse
+	NewOrderError    *APIError
+}
+
+// UnmarshalJSON decode each step as a response or an error
+func (c *CancelReplaceResponse) UnmarshalJSON(data []byte) error {
+	raw := struct {
+		CancelResult     CancelReplaceResultType `json:"cancelResult"`
+		NewOrderResult   CancelReplaceResultType `json:"newOrderResult"`
+		CancelResponse   json.RawMessage         `json:"cancelResponse"`
+		NewOrderResponse json.RawMessage         `json:"newOrderResponse"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	c.CancelResult, c.NewOrderResult = raw.CancelResult, raw.NewOrderResult
+	cancelResponse, newOrderResponse := new(CancelOrderResponse), new(CreateOrderResponse)
+	var ok bool
+	var err error
+	if c.CancelError, ok, err = decodeCancelReplaceStep(raw.CancelResponse, cancelResponse); err != nil {
+		return err
+	} else if ok {
+		c.CancelResponse = cancelResponse
+	}
+	if c.NewOrderError, ok, err = decodeCancelReplaceStep(raw.NewOrderResponse, newOrderResponse); err != nil {
+		return err
+	} else if ok {
+		c.NewOrderResponse = newOrderResponse
+	}
+	return nil
+}
+
+// decodeCancelReplaceStep decode data into v and report if it succeeded,
+// data with an error code is returned as an API error instead
+func decodeCancelReplaceStep(data json.RawMessage, v interface{}) (apiErr *APIError, ok bool, err error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, false, nil
+	}
+	head := struct {
+		Code *int64 `json:"code"`
+	}{}
+	if err = json.Unmarshal(data, &head); err != nil {
+		return nil, false, err
+	}
+	if head.Code != nil {
+		apiErr = new(APIError)
+		if err = json.Unmarshal(data, apiErr); err != nil {
+			return nil, false, err
+		}
+		return apiErr, false, nil
+	}
+	if err = json.Unmarshal(data, v); err != nil {
+		return nil, false, err
+	}
+	return nil, true, nil
+}
+
+// CancelReplaceError is returned when a step of cancelReplace fails, the
+// order may have been canceled even if the new order was not placed
+type CancelReplaceError struct {
+	// APIError is the error of the request, with code ErrCodeCancelReplaceFailed
+	// or ErrCodeCancelReplacePartial
+	APIError *APIError
+	Response *CancelReplaceResponse
+}
+
+// Error return error code, message and the result of each step
+func (e *CancelReplaceError) Error() string {
+	return fmt.Sprintf("<CancelReplaceError> code=%d, msg=%s, cancelResult=%s, newOrderResult=%s",
+		e.APIError.Code, e.APIError.Message, e.Response.CancelResult, e.Response.NewOrderResult)
+}
+
+// Unwrap return the API error
+func (e *CancelReplaceError) Unwrap() error {
+	return e.APIError
+}
+
+// IsPartial check if one step succeeded while the other failed
+func (e *CancelReplaceError) IsPartial() bool {
+	return e.Response.CancelResult == CancelReplaceResultSuccess ||
+		e.Response.NewOrderResult == CancelReplaceResultSuccess
+}
+
+// newCancelReplaceError wrap the API errors carrying the result of the
+// steps, other errors are returned as is
+func newCancelReplaceError(err error) error {
+	apiErr, ok := asAPIError(err)
+	if !ok || len(apiErr.Data) == 0 {
+		return err
+	}
+	if apiErr.Code != ErrCodeCancelReplaceFailed && apiErr.Code != ErrCodeCancelReplacePartial {
+		return err
+	}
+	res := new(CancelReplaceResponse)
+	if e := json.Unmarshal(apiErr.Data, res); e != nil {
+		return err
+	}
+	return &CancelReplaceError{APIError: apiErr, Response: res}
+}
@@ -0,0 +1,177 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type cancelReplaceServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCancelReplaceService(t *testing.T) {
+	suite.Run(t, new(cancelReplaceServiceTestSuite))
+}
+
+func (s *cancelReplaceServiceTestSuite) newService() *CancelReplaceService {
+	return s.client.NewCancelReplaceService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimit).CancelReplaceMode(CancelReplaceModeStopOnFailure).
+		TimeInForce(TimeInForceGTC).Quantity("0.001").Price("30000").CancelOrderID(9)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestCancelReplace() {
+	data := []byte(`{
+        "cancelResult": "SUCCESS",
+        "newOrderResult": "SUCCESS",
+        "cancelResponse": {
+            "symbol": "BTCUSDT",
+            "origClientOrderId": "DnLo3vTAQcjha43lAZhZ0y",
+            "orderId": 9,
+            "orderListId": -1,
+            "clientOrderId": "osxN3JXAtJvKvCqGeMWMVR",
+            "price": "0.01000000",
+            "origQty": "0.000100",
+            "executedQty": "0.00000000",
+            "cummulativeQuoteQty": "0.00000000",
+            "status": "CANCELED",
+            "timeInForce": "GTC",
+            "type": "LIMIT",
+            "side": "SELL"
+        },
+        "newOrderResponse": {
+            "symbol": "BTCUSDT",
+            "orderId": 10,
+            "orderListId": -1,
+            "clientOrderId": "wOceeeOzNORyLiQfw7jd8S",
+            "transactTime": 1652928801803,
+            "price": "30000.00000000",
+            "origQty": "0.00100000",
+            "executedQty": "0.00000000",
+            "cummulativeQuoteQty": "0.00000000",
+            "status": "NEW",
+            "timeInForce": "GTC",
+            "type": "LIMIT",
+            "side": "SELL",
+            "fills": []
+        }
+    }`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "BTCUSDT",
+			"side":                    SideTypeSell,
+			"type":                    OrderTypeLimit,
+			"cancelReplaceMode":       CancelReplaceModeStopOnFailure,
+			"timeInForce":             TimeInForceGTC,
+			"quantity":                "0.001",
+			"price":                   "30000",
+			"cancelOrderId":           9,
+			"cancelNewClientOrderId":  "cancel1",
+			"cancelOrigClientOrderId": "DnLo3vTAQcjha43lAZhZ0y",
+			"newClientOrderId":        "new1",
+			"newOrderRespType":        NewOrderRespTypeFULL,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.newService().CancelNewClientOrderID("cancel1").
+		CancelOrigClientOrderID("DnLo3vTAQcjha43lAZhZ0y").NewClientOrderID("new1").
+		NewOrderRespType(NewOrderRespTypeFULL).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(CancelReplaceResultSuccess, res.CancelResult)
+	r.Equal(CancelReplaceResultSuccess, res.NewOrderResult)
+	r.Nil(res.CancelError)
+	r.Nil(res.NewOrderError)
+	r.Equal(int64(9), res.CancelResponse.OrderID)
+	r.Equal(OrderStatusTypeCanceled, res.CancelResponse.Status)
+	r.Equal(int64(10), res.NewOrderResponse.OrderID)
+	r.Equal("30000.00000000", res.NewOrderResponse.Price)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestCancelFailed() {
+	data := []byte(`{
+        "code": -2022,
+        "msg": "Order cancel-replace failed.",
+        "data": {
+            "cancelResult": "FAILURE",
+            "newOrderResult": "NOT_ATTEMPTED",
+            "cancelResponse": {"code": -2011, "msg": "Unknown order sent."},
+            "newOrderResponse": null
+        }
+    }`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+	_, err := s.newService().Do(newContext())
+	r := s.r()
+	r.Error(err)
+	cancelReplaceErr, ok := err.(*CancelReplaceError)
+	r.True(ok)
+	r.False(cancelReplaceErr.IsPartial())
+	r.Equal(ErrCodeCancelReplaceFailed, cancelReplaceErr.APIError.Code)
+	r.Equal(CancelReplaceResultFailure, cancelReplaceErr.Response.CancelResult)
+	r.Equal(CancelReplaceResultNotAttempted, cancelReplaceErr.Response.NewOrderResult)
+	r.Nil(cancelReplaceErr.Response.CancelResponse)
+	r.Equal(ErrCodeCancelRejected, cancelReplaceErr.Response.CancelError.Code)
+	r.Nil(cancelReplaceErr.Response.NewOrderResponse)
+	r.Nil(cancelReplaceErr.Response.NewOrderError)
+	r.Equal("<CancelReplaceError> code=-2022, msg=Order cancel-replace failed., cancelResult=FAILURE, newOrderResult=NOT_ATTEMPTED", err.Error())
+	r.True(IsAPIError(err))
+}
+
+func (s *cancelReplaceServiceTestSuite) TestPartialFailure() {
+	data := []byte(`{
+        "code": -2021,
+        "msg": "Order cancel-replace partially failed.",
+        "data": {
+            "cancelResult": "SUCCESS",
+            "newOrderResult": "FAILURE",
+            "cancelResponse": {
+                "symbol": "BTCUSDT",
+                "orderId": 9,
+                "status": "CANCELED"
+            },
+            "newOrderResponse": {"code": -2010, "msg": "Order would immediately match and take."}
+        }
+    }`)
+	s.mockDo(data, nil, http.StatusConflict)
+	defer s.assertDo()
+	_, err := s.newService().Do(newContext())
+	r := s.r()
+	var cancelReplaceErr *CancelReplaceError
+	r.True(errors.As(err, &cancelReplaceErr))
+	r.True(cancelReplaceErr.IsPartial())
+	r.False(errors.Is(err, ErrNewOrderRejected))
+	r.Equal(int64(9), cancelReplaceErr.Response.CancelResponse.OrderID)
+	r.Nil(cancelReplaceErr.Response.CancelError)
+	r.Nil(cancelReplaceErr.Response.NewOrderResponse)
+	r.True(errors.Is(cancelReplaceErr.Response.NewOrderError, ErrNewOrderRejected))
+	r.Equal(http.StatusConflict, cancelReplaceErr.APIError.StatusCode)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestOtherError() {
+	s.mockDo([]byte(`{"code":-1102,"msg":"Mandatory parameter 'cancelReplaceMode' was not sent."}`), nil, http.StatusBadRequest)
+	defer s.assertDo()
+	_, err := s.client.NewCancelReplaceService().Symbol("BTCUSDT").Do(newContext())
+	r := s.r()
+	_, ok := err.(*APIError)
+	r.True(ok)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestWrappedError() {
+	apiErr := APIError{
+		Code:    ErrCodeCancelReplaceFailed,
+		Message: "Order cancel-replace failed.",
+		Data:    []byte(`{"cancelResult": "FAILURE", "newOrderResult": "NOT_ATTEMPTED"}`),
+	}
+	r := s.r()
+	for _, err := range []error{apiErr, &apiErr, fmt.Errorf("wrapped: %w", apiErr)} {
+		var cancelReplaceErr *CancelReplaceError
+		r.True(errors.As(newCancelReplaceError(err), &cancelReplaceErr), "%v", err)
+		r.Equal(CancelReplaceResultFailure, cancelReplaceErr.Response.CancelResult)
+	}
+}
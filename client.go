@@ -3,8 +3,6 @@ package binance
 import (
 	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -12,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/bitly/go-simplejson"
@@ -26,6 +25,9 @@ type OrderType string
 // TimeInForce define time in force type of order
 type TimeInForce string
 
+// NewOrderRespType define response JSON verbosity
+type NewOrderRespType string
+
 // Global enums
 const (
 	SideTypeBuy  SideType = "BUY"
@@ -43,15 +45,15 @@ const (
 	TimeInForceIOC TimeInForce = "IOC"
 	TimeInForceFOK TimeInForce = "FOK"
 
+	NewOrderRespTypeACK    NewOrderRespType = "ACK"
+	NewOrderRespTypeRESULT NewOrderRespType = "RESULT"
+	NewOrderRespTypeFULL   NewOrderRespType = "FULL"
+
 	timestampKey  = "timestamp"
 	signatureKey  = "signature"
 	recvWindowKey = "recvWindow"
 )
 
-func currentTimestamp() int64 {
-	return int64(time.Nanosecond) * time.Now().UnixNano() / int64(time.Millisecond)
-}
-
 func newJSON(data []byte) (j *simplejson.Json, err error) {
 	j, err = simplejson.NewJson(data)
 	if err != nil {
@@ -74,18 +76,45 @@ func NewClient(apiKey, secretKey string) *Client {
 	}
 }
 
+// NewClientWithSigner initialize an API client instance with API key and a
+// signer holding the private key, see NewRSASigner and NewEd25519Signer.
+func NewClientWithSigner(apiKey string, signer Signer) *Client {
+	c := NewClient(apiKey, "")
+	c.Signer = signer
+	return c
+}
+
 type doFunc func(req *http.Request) (*http.Response, error)
 
 // Client define API client
 type Client struct {
-	APIKey     string
-	SecretKey  string
+	APIKey    string
+	SecretKey string
+	// Signer signs requests instead of the HMAC of SecretKey when set
+	Signer     Signer
 	BaseURL    string
 	UserAgent  string
 	HTTPClient *http.Client
 	Debug      bool
 	Logger     *log.Logger
-	do         doFunc
+	// RetryPolicy enables retrying failed calls, nil means a single attempt
+	RetryPolicy *RetryPolicy
+	// RateLimiter throttles requests on the client side, nil disables it
+	RateLimiter *RateLimiter
+	// RateLimitUsageHandler is called with the usage reported by every response
+	RateLimitUsageHandler RateLimitUsageHandler
+	// RateLimitedHandler is called when a 429 or 418 response starts a cooldown
+	RateLimitedHandler RateLimitedHandler
+	// TimeSyncInterval enables refreshing the server time offset before
+	// signed requests when the last sync is older than the interval, see
+	// also StartTimeSync to refresh it in the background
+	TimeSyncInterval time.Duration
+	usage            rateLimitUsageTracker
+	cooldown         cooldown
+	timeSync         timeSync
+	exchangeInfo     *ExchangeInfoRegistry
+	exchangeInfoOnce sync.Once
+	do               doFunc
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -94,11 +123,7 @@ func (c *Client) debug(format string, v ...interface{}) {
 	}
 }
 
-func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
-	// set request options from user
-	for _, opt := range opts {
-		opt(r)
-	}
+func (c *Client) parseRequest(r *request) (err error) {
 	err = r.validate()
 	if err != nil {
 		return
@@ -109,7 +134,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp())
+		r.setParam(timestampKey, c.timestamp())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -125,10 +150,13 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 
 	if r.secType == secTypeSigned {
 		raw := fmt.Sprintf("%s%s", queryString, bodyString)
-		mac := hmac.New(sha256.New, []byte(c.SecretKey))
-		mac.Write([]byte(raw))
+		var signature string
+		signature, err = c.signer().Sign([]byte(raw))
+		if err != nil {
+			return
+		}
 		v := url.Values{}
-		v.Set(signatureKey, fmt.Sprintf("%x", (mac.Sum(nil))))
+		v.Set(signatureKey, signature)
 		if queryString == "" {
 			queryString = v.Encode()
 		} else {
@@ -147,10 +175,69 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
-	err = c.parseRequest(r, opts...)
-	if err != nil {
-		return
+	// set request options from user
+	for _, opt := range opts {
+		opt(r)
+	}
+	limiter := c.RateLimiter
+	if r.rateLimiter != nil {
+		limiter = r.rateLimiter
+	}
+	resynced := false
+	for attempt := 1; ; attempt++ {
+		err = c.cooldown.check(time.Now())
+		if err != nil {
+			return
+		}
+		// the weight only depends on the endpoint and query, waiting before
+		// the request is stamped keeps the timestamp fresh
+		err = limiter.wait(ctx, r)
+		if err != nil {
+			return
+		}
+		// a cooldown may have started while waiting
+		err = c.cooldown.check(time.Now())
+		if err != nil {
+			return
+		}
+		if r.secType == secTypeSigned {
+			c.syncTimeIfStale(ctx)
+		}
+		// signed requests are stamped and signed again on every attempt
+		err = c.parseRequest(r)
+		if err != nil {
+			return
+		}
+		var statusCode int
+		data, statusCode, err = c.doRequest(ctx, r)
+		if err == nil {
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		// the request was rejected before being processed, so it is safe to
+		// send it again once with a fresh time offset
+		if r.secType == secTypeSigned && !resynced && isTimestampError(err) {
+			resynced = true
+			if e := c.SyncTime(ctx); e == nil {
+				attempt--
+				continue
+			}
+		}
+		if !c.RetryPolicy.shouldRetry(r, attempt, statusCode, err) {
+			return
+		}
+		delay := c.RetryPolicy.delay(attempt)
+		c.debug("attempt %d failed: %s, retrying in %s", attempt, err, delay)
+		if sleepContext(ctx, delay) != nil {
+			return
+		}
 	}
+}
+
+// doRequest send a parsed request once, statusCode is 0 if no response was received
+func (c *Client) doRequest(ctx context.Context, r *request) (data []byte, statusCode int, err error) {
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
 		return
@@ -166,21 +253,30 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	if err != nil {
 		return
 	}
+	defer res.Body.Close()
+	statusCode = res.StatusCode
+	c.recordUsage(res.Header)
 	data, err = ioutil.ReadAll(res.Body)
 	if err != nil {
 		return
 	}
-	defer res.Body.Close()
 	c.debug("response: %#v", res)
 	c.debug("response body: %s", string(data))
 
 	if res.StatusCode >= 400 {
-		apiErr := new(APIError)
+		apiErr := &APIError{
+			StatusCode: statusCode,
+			Header:     res.Header,
+		}
 		e := json.Unmarshal(data, apiErr)
 		if e != nil {
-			c.debug("failed to unmarshal json: %s", err)
+			c.debug("failed to unmarshal json: %s", e)
+			apiErr.Message = string(data)
 		}
-		return nil, apiErr
+		if isRateLimitedStatus(statusCode) {
+			return nil, statusCode, c.rateLimited(statusCode, res.Header, apiErr)
+		}
+		return nil, statusCode, apiErr
 	}
 	return
 }
@@ -250,6 +346,16 @@ func (c *Client) NewCancelOrderService() *CancelOrderService {
 	return &CancelOrderService{c: c}
 }
 
+// NewCancelOpenOrdersService init cancel open orders service
+func (c *Client) NewCancelOpenOrdersService() *CancelOpenOrdersService {
+	return &CancelOpenOrdersService{c: c}
+}
+
+// NewCancelReplaceService init cancel replace service
+func (c *Client) NewCancelReplaceService() *CancelReplaceService {
+	return &CancelReplaceService{c: c}
+}
+
 // NewListOpenOrdersService init list open orders service
 func (c *Client) NewListOpenOrdersService() *ListOpenOrdersService {
 	return &ListOpenOrdersService{c: c}
@@ -313,3 +419,28 @@ func (c *Client) NewHistoricalTradesService() *HistoricalTradesService {
 func (c *Client) NewListPriceChangeStatsService() *ListPriceChangeStatsService {
 	return &ListPriceChangeStatsService{c: c}
 }
+
+// NewCreateOCOService init creating OCO order list service
+func (c *Client) NewCreateOCOService() *CreateOCOService {
+	return &CreateOCOService{c: c}
+}
+
+// NewCancelOrderListService init cancel order list service
+func (c *Client) NewCancelOrderListService() *CancelOrderListService {
+	return &CancelOrderListService{c: c}
+}
+
+// NewGetOrderListService init get order list service
+func (c *Client) NewGetOrderListService() *GetOrderListService {
+	return &GetOrderListService{c: c}
+}
+
+// NewListOrderListsService init listing order lists service
+func (c *Client) NewListOrderListsService() *ListOrderListsService {
+	return &ListOrderListsService{c: c}
+}
+
+// NewListOpenOrderListsService init listing open order lists service
+func (c *Client) NewListOpenOrderListsService() *ListOpenOrderListsService {
+	return &ListOpenOrderListsService{c: c}
+}
@@ -38,6 +38,12 @@ func (s *baseTestSuite) mockDo(data []byte, err error, statusCode ...int) {
 	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(data, code), err)
 }
 
+// mockDoOnce mock a single response, calls are answered in the order they are mocked
+func (s *baseTestSuite) mockDoOnce(data []byte, err error, statusCode int) {
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse(data, statusCode), err).Once()
+}
+
 func (s *baseTestSuite) assertDo() {
 	s.client.AssertCalled(s.T(), "do", anyHTTPRequest())
 }
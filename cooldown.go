@@ -0,0 +1,119 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimitCooldown is the cooldown applied after a 429 or 418
+// response without a usable Retry-After header
+var DefaultRateLimitCooldown = time.Minute
+
+// RateLimitedError is returned when the API answered with HTTP 429 (too many
+// requests) or 418 (IP banned), and for every call made before Until
+type RateLimitedError struct {
+	StatusCode int
+	Until      time.Time
+	// APIError is the error returned by the API, nil for short-circuited calls
+	APIError *APIError
+}
+
+// Error return status code and unblock time
+func (e *RateLimitedError) Error() string {
+	msg := fmt.Sprintf("<RateLimitedError> status=%d, until=%s", e.StatusCode, e.Until.Format(time.RFC3339))
+	if e.APIError != nil {
+		msg = fmt.Sprintf("%s, code=%d, msg=%s", msg, e.APIError.Code, e.APIError.Message)
+	}
+	return msg
+}
+
+// Unwrap return the underlying API error
+func (e *RateLimitedError) Unwrap() error {
+	if e.APIError == nil {
+		return nil
+	}
+	return e.APIError
+}
+
+// IsBanned check if the IP address has been banned (HTTP 418)
+func (e *RateLimitedError) IsBanned() bool {
+	return e.StatusCode == http.StatusTeapot
+}
+
+// RateLimitedHandler handle the start of a client wide cooldown
+type RateLimitedHandler func(err *RateLimitedError)
+
+// cooldown block every call of a client until the API accepts requests again
+type cooldown struct {
+	mu         sync.Mutex
+	until      time.Time
+	statusCode int
+}
+
+// check return a RateLimitedError if now is before the end of the cooldown
+func (c *cooldown) check(now time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now.Before(c.until) {
+		return &RateLimitedError{StatusCode: c.statusCode, Until: c.until}
+	}
+	return nil
+}
+
+// extend move the end of the cooldown to until unless it already ends later
+func (c *cooldown) extend(statusCode int, until time.Time) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if until.After(c.until) {
+		c.until = until
+		c.statusCode = statusCode
+	}
+	return c.until
+}
+
+// RateLimitedUntil return the time until which calls are short-circuited, zero if none
+func (c *Client) RateLimitedUntil() time.Time {
+	c.cooldown.mu.Lock()
+	defer c.cooldown.mu.Unlock()
+	if time.Now().Before(c.cooldown.until) {
+		return c.cooldown.until
+	}
+	return time.Time{}
+}
+
+// rateLimited start a cooldown after a 429 or 418 response
+func (c *Client) rateLimited(statusCode int, header http.Header, apiErr *APIError) error {
+	now := time.Now()
+	until := c.cooldown.extend(statusCode, now.Add(parseRetryAfter(header.Get("Retry-After"), now)))
+	err := &RateLimitedError{
+		StatusCode: statusCode,
+		Until:      until,
+		APIError:   apiErr,
+	}
+	c.debug("rate limited: %s", err)
+	if c.RateLimitedHandler != nil {
+		c.RateLimitedHandler(err)
+	}
+	return err
+}
+
+// parseRetryAfter parse a Retry-After header in seconds or HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return DefaultRateLimitCooldown
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return DefaultRateLimitCooldown
+}
+
+func isRateLimitedStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusTeapot
+}
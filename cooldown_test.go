@@ -0,0 +1,99 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type cooldownTestSuite struct {
+	baseTestSuite
+}
+
+func TestCooldown(t *testing.T) {
+	suite.Run(t, new(cooldownTestSuite))
+}
+
+func (s *cooldownTestSuite) mockRateLimited(statusCode int, retryAfter string) {
+	s.client.Client.do = s.client.do
+	res := newHTTPResponse([]byte(`{"code":-1003,"msg":"Too many requests."}`), statusCode)
+	res.Header = http.Header{}
+	if retryAfter != "" {
+		res.Header.Set("Retry-After", retryAfter)
+	}
+	s.client.On("do", anyHTTPRequest()).Return(res, nil).Once()
+}
+
+func (s *cooldownTestSuite) TestTooManyRequests() {
+	s.mockRateLimited(http.StatusTooManyRequests, "120")
+	var handled []*RateLimitedError
+	s.client.RateLimitedHandler = func(err *RateLimitedError) {
+		handled = append(handled, err)
+	}
+
+	start := time.Now()
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	r := s.r()
+	r.Error(err)
+	rateLimitedErr, ok := err.(*RateLimitedError)
+	r.True(ok)
+	r.Equal(http.StatusTooManyRequests, rateLimitedErr.StatusCode)
+	r.False(rateLimitedErr.IsBanned())
+	r.WithinDuration(start.Add(120*time.Second), rateLimitedErr.Until, time.Second)
+	r.EqualValues(-1003, rateLimitedErr.APIError.Code)
+	r.Len(handled, 1)
+	r.Equal(rateLimitedErr, handled[0])
+	r.Equal(rateLimitedErr.Until, s.client.RateLimitedUntil())
+
+	var apiErr *APIError
+	r.True(errors.As(err, &apiErr))
+
+	// following calls are short-circuited without reaching the server
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	r.Error(err)
+	rateLimitedErr, ok = err.(*RateLimitedError)
+	r.True(ok)
+	r.Nil(rateLimitedErr.APIError)
+	r.Equal(handled[0].Until, rateLimitedErr.Until)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+	r.Len(handled, 1)
+}
+
+func (s *cooldownTestSuite) TestBanned() {
+	s.client.RetryPolicy = &RetryPolicy{MaxAttempts: 3}
+	s.mockRateLimited(http.StatusTeapot, "")
+
+	start := time.Now()
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	r := s.r()
+	rateLimitedErr, ok := err.(*RateLimitedError)
+	r.True(ok)
+	r.True(rateLimitedErr.IsBanned())
+	r.WithinDuration(start.Add(DefaultRateLimitCooldown), rateLimitedErr.Until, time.Second)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *cooldownTestSuite) TestCooldownExpires() {
+	s.mockRateLimited(http.StatusTooManyRequests, "0")
+	s.mockDoOnce([]byte(`{"serverTime": 1499827319559}`), nil, http.StatusOK)
+
+	r := s.r()
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	r.Error(err)
+	r.True(s.client.RateLimitedUntil().IsZero())
+	serverTime, err := s.client.NewServerTimeService().Do(newContext())
+	r.NoError(err)
+	r.EqualValues(1499827319559, serverTime)
+}
+
+func (s *cooldownTestSuite) TestParseRetryAfter() {
+	now := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := s.r()
+	r.Equal(30*time.Second, parseRetryAfter("30", now))
+	r.Equal(DefaultRateLimitCooldown, parseRetryAfter("", now))
+	r.Equal(DefaultRateLimitCooldown, parseRetryAfter("soon", now))
+	r.Equal(90*time.Second, parseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now))
+}
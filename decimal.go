@@ -0,0 +1,352 @@
+package binance
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal define an exact fixed-point decimal number used for prices,
+// quantities and balances. The zero value is 0. Decimals are immutable,
+// every operation returns a new value.
+//
+// The XxxDecimal accessors of the API types return zero when the field is
+// empty or malformed, use ParseDecimal on the field to detect invalid values.
+type Decimal struct {
+	// value is the unscaled value, the number is value * 10^-scale
+	value *big.Int
+	scale int32
+}
+
+var bigTen = big.NewInt(10)
+
+// maxDecimalExponent bound the exponent accepted by ParseDecimal, larger
+// exponents would allocate huge numbers
+const maxDecimalExponent = 1000
+
+// NewDecimal init a decimal equal to value * 10^-scale
+func NewDecimal(value int64, scale int32) Decimal {
+	d := Decimal{value: big.NewInt(value)}
+	if scale < 0 {
+		d.value.Mul(d.value, pow10(-scale))
+		return d
+	}
+	d.scale = scale
+	return d
+}
+
+// ParseDecimal parse a decimal from its string representation like
+// "-12.345", "0.00100000" or "1E-8"
+func ParseDecimal(s string) (Decimal, error) {
+	orig := s
+	exp := int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil || e > maxDecimalExponent || e < -maxDecimalExponent {
+			return Decimal{}, fmt.Errorf("binance: invalid decimal %q", orig)
+		}
+		exp = e
+		s = s[:i]
+	}
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if intPart+fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return Decimal{}, fmt.Errorf("binance: invalid decimal %q", orig)
+	}
+	value, _ := new(big.Int).SetString(intPart+fracPart, 10)
+	if neg {
+		value.Neg(value)
+	}
+	scale := int64(len(fracPart)) - exp
+	if scale < 0 {
+		value.Mul(value, pow10(int32(-scale)))
+		scale = 0
+	}
+	return Decimal{value: value, scale: int32(scale)}, nil
+}
+
+// MustParseDecimal parse a decimal and panic if s is invalid
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDecimalOrZero parse s and return zero if s is empty or invalid, it
+// backs the decimal accessors of the API types
+func parseDecimalOrZero(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		return Decimal{}
+	}
+	return d
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) bigInt() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+	return d.value
+}
+
+// rescale return the unscaled value of d at a larger scale
+func (d Decimal) rescale(scale int32) *big.Int {
+	v := new(big.Int).Set(d.bigInt())
+	if scale > d.scale {
+		v.Mul(v, pow10(scale-d.scale))
+	}
+	return v
+}
+
+func align(a, b Decimal) (*big.Int, *big.Int, int32) {
+	scale := a.scale
+	if b.scale > scale {
+		scale = b.scale
+	}
+	return a.rescale(scale), b.rescale(scale), scale
+}
+
+// Scale return the number of digits after the decimal point
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Add return d + d2
+func (d Decimal) Add(d2 Decimal) Decimal {
+	a, b, scale := align(d, d2)
+	return Decimal{value: a.Add(a, b), scale: scale}
+}
+
+// Sub return d - d2
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	a, b, scale := align(d, d2)
+	return Decimal{value: a.Sub(a, b), scale: scale}
+}
+
+// Mul return d * d2
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return Decimal{value: new(big.Int).Mul(d.bigInt(), d2.bigInt()), scale: d.scale + d2.scale}
+}
+
+// Div return d / d2 rounded half away from zero to places digits after the
+// decimal point. It panics if d2 is zero.
+func (d Decimal) Div(d2 Decimal, places int32) Decimal {
+	if d2.IsZero() {
+		panic("binance: decimal division by zero")
+	}
+	// compute with one more digit and round it, negative places round
+	// the integer part
+	scale := places + 1
+	if scale < 1 {
+		scale = 1
+	}
+	num := new(big.Int).Mul(d.bigInt(), pow10(scale+d2.scale))
+	den := new(big.Int).Mul(d2.bigInt(), pow10(d.scale))
+	q := Decimal{value: num.Quo(num, den), scale: scale}
+	return q.Round(places)
+}
+
+// Mod return the remainder of d / d2 truncated toward zero. It panics if d2 is zero.
+func (d Decimal) Mod(d2 Decimal) Decimal {
+	if d2.IsZero() {
+		panic("binance: decimal division by zero")
+	}
+	a, b, scale := align(d, d2)
+	return Decimal{value: a.Rem(a, b), scale: scale}
+}
+
+// Neg return -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{value: new(big.Int).Neg(d.bigInt()), scale: d.scale}
+}
+
+// Abs return |d|
+func (d Decimal) Abs() Decimal {
+	return Decimal{value: new(big.Int).Abs(d.bigInt()), scale: d.scale}
+}
+
+// Sign return -1, 0 or 1 depending on the sign of d
+func (d Decimal) Sign() int {
+	return d.bigInt().Sign()
+}
+
+// IsZero check if d is 0
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compare d and d2 and return -1, 0 or 1
+func (d Decimal) Cmp(d2 Decimal) int {
+	a, b, _ := align(d, d2)
+	return a.Cmp(b)
+}
+
+// Equal check if d == d2 regardless of their scale
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// LessThan check if d < d2
+func (d Decimal) LessThan(d2 Decimal) bool {
+	return d.Cmp(d2) < 0
+}
+
+// GreaterThan check if d > d2
+func (d Decimal) GreaterThan(d2 Decimal) bool {
+	return d.Cmp(d2) > 0
+}
+
+type roundingMode int
+
+const (
+	roundHalfUp roundingMode = iota
+	roundDown
+	roundFloor
+	roundCeil
+)
+
+func (d Decimal) round(places int32, mode roundingMode) Decimal {
+	if places >= d.scale {
+		return Decimal{value: d.rescale(places), scale: places}
+	}
+	unit := pow10(d.scale - places)
+	q, m := new(big.Int).QuoRem(d.bigInt(), unit, new(big.Int))
+	if m.Sign() != 0 {
+		switch mode {
+		case roundHalfUp:
+			if new(big.Int).Mul(new(big.Int).Abs(m), big.NewInt(2)).Cmp(unit) >= 0 {
+				q.Add(q, big.NewInt(int64(m.Sign())))
+			}
+		case roundFloor:
+			if m.Sign() < 0 {
+				q.Sub(q, big.NewInt(1))
+			}
+		case roundCeil:
+			if m.Sign() > 0 {
+				q.Add(q, big.NewInt(1))
+			}
+		}
+	}
+	if places < 0 {
+		// keep the scale positive, the rounded digits are zeros
+		return Decimal{value: q.Mul(q, pow10(-places)), scale: 0}
+	}
+	return Decimal{value: q, scale: places}
+}
+
+// Round round d half away from zero to places digits after the decimal point
+// or to a multiple of 10^-places when places is negative
+func (d Decimal) Round(places int32) Decimal {
+	return d.round(places, roundHalfUp)
+}
+
+// Truncate round d toward zero to places digits after the decimal point
+func (d Decimal) Truncate(places int32) Decimal {
+	return d.round(places, roundDown)
+}
+
+// Floor round d toward negative infinity to places digits after the decimal point
+func (d Decimal) Floor(places int32) Decimal {
+	return d.round(places, roundFloor)
+}
+
+// Ceil round d toward positive infinity to places digits after the decimal point
+func (d Decimal) Ceil(places int32) Decimal {
+	return d.round(places, roundCeil)
+}
+
+// String return d in plain notation with all the digits of its scale
+func (d Decimal) String() string {
+	v := d.bigInt()
+	digits := new(big.Int).Abs(v).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) - len(digits) + 1; pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		i := len(digits) - int(d.scale)
+		digits = digits[:i] + "." + digits[i:]
+	}
+	if v.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// StringFixed return d rounded half away from zero with exactly places digits
+// after the decimal point
+func (d Decimal) StringFixed(places int32) string {
+	return d.Round(places).String()
+}
+
+// Float64 return the nearest float64 value of d
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// MarshalJSON encode d as a JSON string like the API does
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decode d from a JSON string or number
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*d = Decimal{}
+		return nil
+	}
+	s := string(data)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	if s == "" {
+		*d = Decimal{}
+		return nil
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalText encode d in plain notation
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decode d from plain or scientific notation
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
@@ -0,0 +1,184 @@
+package binance
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type decimalTestSuite struct {
+	baseTestSuite
+}
+
+func TestDecimal(t *testing.T) {
+	suite.Run(t, new(decimalTestSuite))
+}
+
+func (s *decimalTestSuite) TestParseDecimal() {
+	r := s.r()
+	for _, tc := range []struct {
+		in    string
+		out   string
+		scale int32
+	}{
+		{"0", "0", 0},
+		{"12.345", "12.345", 3},
+		{"-0.00100000", "-0.00100000", 8},
+		{"+7", "7", 0},
+		{".5", "0.5", 1},
+		{"5.", "5", 0},
+		{"1E-8", "0.00000001", 8},
+		{"1.5e3", "1500", 0},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789", 9},
+	} {
+		d, err := ParseDecimal(tc.in)
+		r.NoError(err, tc.in)
+		r.Equal(tc.out, d.String(), tc.in)
+		r.Equal(tc.scale, d.Scale(), tc.in)
+	}
+	for _, in := range []string{"", "-", ".", "abc", "1.2.3", "1-2", "--1", "1e", "0x10", " 1", "1E999999999", "1E-999999999", "1E1001"} {
+		_, err := ParseDecimal(in)
+		r.Error(err, in)
+	}
+	r.Equal("1"+strings.Repeat("0", 1000), MustParseDecimal("1E1000").String())
+	r.Panics(func() {
+		MustParseDecimal("bad")
+	})
+}
+
+func (s *decimalTestSuite) TestArithmetic() {
+	r := s.r()
+	a := MustParseDecimal("0.1")
+	b := MustParseDecimal("0.2")
+	r.Equal("0.3", a.Add(b).String())
+	r.True(a.Add(b).Equal(MustParseDecimal("0.30000")))
+	r.Equal("-0.1", a.Sub(b).String())
+	r.Equal("0.02", a.Mul(b).String())
+	r.Equal("0.33333333", MustParseDecimal("1").Div(MustParseDecimal("3"), 8).String())
+	r.Equal("0.67", MustParseDecimal("2").Div(MustParseDecimal("3"), 2).String())
+	r.Equal("-2.5", MustParseDecimal("-5").Div(MustParseDecimal("2"), 1).String())
+	r.Equal("0.05", MustParseDecimal("1.05").Mod(MustParseDecimal("0.1")).String())
+	r.True(MustParseDecimal("1.2").Mod(MustParseDecimal("0.01")).IsZero())
+	r.Equal("-0.5", MustParseDecimal("-2.5").Mod(MustParseDecimal("1")).String())
+	r.Equal("0.1", a.Abs().String())
+	r.Equal("-0.1", a.Neg().String())
+	r.Equal("0.1", a.Neg().Abs().String())
+	r.Panics(func() {
+		a.Div(Decimal{}, 2)
+	})
+
+	var zero Decimal
+	r.True(zero.IsZero())
+	r.Equal("0", zero.String())
+	r.Equal("0.1", zero.Add(a).String())
+	r.Equal(0, zero.Sign())
+	r.Equal(-1, a.Neg().Sign())
+	r.Equal("1.23", NewDecimal(123, 2).String())
+	r.Equal("1200", NewDecimal(12, -2).String())
+}
+
+func (s *decimalTestSuite) TestCompare() {
+	r := s.r()
+	a := MustParseDecimal("1.50")
+	b := MustParseDecimal("1.5")
+	c := MustParseDecimal("1.51")
+	r.Equal(0, a.Cmp(b))
+	r.True(a.Equal(b))
+	r.True(a.LessThan(c))
+	r.True(c.GreaterThan(b))
+	r.False(a.GreaterThan(b))
+}
+
+func (s *decimalTestSuite) TestRounding() {
+	r := s.r()
+	d := MustParseDecimal("1.2345")
+	r.Equal("1.235", d.Round(3).String())
+	r.Equal("1.234", d.Truncate(3).String())
+	r.Equal("1.234", d.Floor(3).String())
+	r.Equal("1.235", d.Ceil(3).String())
+	r.Equal("1.23450", d.Round(5).String())
+
+	n := d.Neg()
+	r.Equal("-1.235", n.Round(3).String())
+	r.Equal("-1.234", n.Truncate(3).String())
+	r.Equal("-1.235", n.Floor(3).String())
+	r.Equal("-1.234", n.Ceil(3).String())
+
+	r.Equal("2", MustParseDecimal("1.5").Round(0).String())
+	r.Equal("0.00", MustParseDecimal("0.004").StringFixed(2))
+	r.Equal("0.01", MustParseDecimal("0.005").StringFixed(2))
+	r.Equal("12.50000000", MustParseDecimal("12.5").StringFixed(8))
+	r.Equal("1", MustParseDecimal("1.000").Truncate(0).String())
+
+	r.Equal("120", NewDecimal(123, 0).Round(-1).String())
+	r.Equal("-200", MustParseDecimal("-150.5").Round(-2).String())
+	r.Equal("1200", MustParseDecimal("1234.5").Floor(-2).String())
+	r.Equal("1300", MustParseDecimal("1234.5").Ceil(-2).String())
+	r.Equal(0, NewDecimal(120, 0).Cmp(NewDecimal(123, 0).Round(-1)))
+}
+
+func (s *decimalTestSuite) TestDivNegativePlaces() {
+	r := s.r()
+	r.Equal("330", MustParseDecimal("1000").Div(MustParseDecimal("3"), -1).String())
+	r.Equal("300", MustParseDecimal("1000").Div(MustParseDecimal("3"), -2).String())
+	r.Equal("-3300", MustParseDecimal("-10000").Div(MustParseDecimal("3"), -2).String())
+	r.Equal("0", MustParseDecimal("1").Div(MustParseDecimal("3"), -1).String())
+}
+
+func (s *decimalTestSuite) TestFloat64() {
+	s.r().InDelta(0.04670582, MustParseDecimal("0.04670582").Float64(), 1e-15)
+}
+
+func (s *decimalTestSuite) TestJSON() {
+	r := s.r()
+	var v struct {
+		Price    Decimal  `json:"price"`
+		Quantity Decimal  `json:"qty"`
+		Empty    Decimal  `json:"empty"`
+		Null     Decimal  `json:"null"`
+		Ptr      *Decimal `json:"ptr"`
+	}
+	err := json.Unmarshal([]byte(`{"price":"0.00012345","qty":1.5,"empty":"","null":null,"ptr":"2"}`), &v)
+	r.NoError(err)
+	r.Equal("0.00012345", v.Price.String())
+	r.Equal("1.5", v.Quantity.String())
+	r.True(v.Empty.IsZero())
+	r.True(v.Null.IsZero())
+	r.Equal("2", v.Ptr.String())
+
+	data, err := json.Marshal(v.Price)
+	r.NoError(err)
+	r.Equal(`"0.00012345"`, string(data))
+
+	r.Error(json.Unmarshal([]byte(`{"price":"abc"}`), &v))
+
+	var d Decimal
+	r.NoError(d.UnmarshalText([]byte("3.14")))
+	text, err := d.MarshalText()
+	r.NoError(err)
+	r.Equal("3.14", string(text))
+}
+
+func (s *decimalTestSuite) TestAccessors() {
+	r := s.r()
+	b := Balance{Asset: "BTC", Free: "4723846.89208129", Locked: ""}
+	r.Equal("4723846.89208129", b.FreeDecimal().String())
+	r.True(b.LockedDecimal().IsZero())
+
+	k := &Kline{Close: "0.01634790"}
+	r.True(k.CloseDecimal().Equal(MustParseDecimal("0.0163479")))
+
+	bid := Bid{Price: "0.10376590", Quantity: "59.15767010"}
+	r.Equal("6.1385", bid.PriceDecimal().Mul(bid.QuantityDecimal()).StringFixed(4))
+
+	d := &Deposit{Amount: 0.5}
+	r.Equal("0.5", d.AmountDecimal().String())
+
+	c := &CancelOrderResponse{Price: "0.1", OrigQuantity: "2.00", ExecutedQuantity: "1.5", CummulativeQuoteQuantity: "0.15"}
+	r.Equal("0.1", c.PriceDecimal().String())
+	r.Equal("2.00", c.OrigQuantityDecimal().String())
+	r.Equal("1.5", c.ExecutedQuantityDecimal().String())
+	r.Equal("0.15", c.CummulativeQuoteQuantityDecimal().String())
+}
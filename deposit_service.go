@@ -3,6 +3,8 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"strconv"
+	"time"
 )
 
 // ListDepositsService list deposits
@@ -38,6 +40,16 @@ func (s *ListDepositsService) EndTime(endTime int64) *ListDepositsService {
 	return s
 }
 
+// Since set startTime from t
+func (s *ListDepositsService) Since(t time.Time) *ListDepositsService {
+	return s.StartTime(TimeToMillis(t))
+}
+
+// Until set endTime from t
+func (s *ListDepositsService) Until(t time.Time) *ListDepositsService {
+	return s.EndTime(TimeToMillis(t))
+}
+
 // Do send request
 func (s *ListDepositsService) Do(ctx context.Context, opts ...RequestOption) (deposits []*Deposit, err error) {
 	r := &request{
@@ -84,4 +96,40 @@ type Deposit struct {
 	Amount     float64 `json:"amount"`
 	Asset      string  `json:"asset"`
 	Status     int     `json:"status"`
+	amount     Decimal
+}
+
+// InsertTimeUTC return insert time as a UTC time
+func (d *Deposit) InsertTimeUTC() time.Time {
+	return MillisToTime(d.InsertTime)
+}
+
+// UnmarshalJSON decode a deposit keeping the exact amount
+func (d *Deposit) UnmarshalJSON(data []byte) error {
+	type deposit Deposit
+	aux := &struct {
+		*deposit
+		Amount json.Number `json:"amount"`
+	}{deposit: (*deposit)(d)}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	d.Amount, d.amount = 0, Decimal{}
+	if aux.Amount == "" {
+		return nil
+	}
+	var err error
+	if d.Amount, err = aux.Amount.Float64(); err != nil {
+		return err
+	}
+	d.amount, err = ParseDecimal(aux.Amount.String())
+	return err
+}
+
+// AmountDecimal return the exact amount as a decimal
+func (d *Deposit) AmountDecimal() Decimal {
+	if d.amount.value == nil {
+		return parseDecimalOrZero(strconv.FormatFloat(d.Amount, 'f', -1, 64))
+	}
+	return d.amount
 }
@@ -51,6 +51,7 @@ func (s *depositServiceTestSuite) TestListDeposits() {
 		Status:     1,
 	}
 	s.assertDepositEqual(e, deposits[0])
+	r.Equal("0.04670582", deposits[0].AmountDecimal().String())
 }
 
 func (s *depositServiceTestSuite) assertDepositEqual(e, a *Deposit) {
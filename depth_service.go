@@ -77,8 +77,28 @@ type Bid struct {
 	Quantity string
 }
 
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (b Bid) PriceDecimal() Decimal {
+	return parseDecimalOrZero(b.Price)
+}
+
+// QuantityDecimal return quantity as a decimal, zero if it is empty or malformed
+func (b Bid) QuantityDecimal() Decimal {
+	return parseDecimalOrZero(b.Quantity)
+}
+
 // Ask define ask info with price and quantity
 type Ask struct {
 	Price    string
 	Quantity string
 }
+
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (a Ask) PriceDecimal() Decimal {
+	return parseDecimalOrZero(a.Price)
+}
+
+// QuantityDecimal return quantity as a decimal, zero if it is empty or malformed
+func (a Ask) QuantityDecimal() Decimal {
+	return parseDecimalOrZero(a.Quantity)
+}
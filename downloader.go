@@ -0,0 +1,517 @@
+package binance
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DownloadFormat define the format of downloaded files
+type DownloadFormat string
+
+// DownloadDataType define the kind of market data to download
+type DownloadDataType string
+
+// Download formats and data types
+const (
+	DownloadFormatCSV   DownloadFormat = "csv"
+	DownloadFormatJSONL DownloadFormat = "jsonl"
+
+	DownloadDataTypeKlines    DownloadDataType = "klines"
+	DownloadDataTypeAggTrades DownloadDataType = "aggTrades"
+)
+
+var (
+	klineCSVHeader = []string{"open_time", "open", "high", "low", "close", "volume", "close_time",
+		"quote_asset_volume", "trades", "taker_buy_base_asset_volume", "taker_buy_quote_asset_volume"}
+	aggTradeCSVHeader = []string{"agg_trade_id", "price", "quantity", "first_trade_id", "last_trade_id",
+		"timestamp", "is_buyer_maker", "is_best_price_match"}
+)
+
+// DownloadJob define the market data of a symbol to download between
+// StartTime and EndTime in ms, both included
+type DownloadJob struct {
+	Symbol    string
+	DataType  DownloadDataType
+	Interval  KlineInterval // klines only
+	StartTime int64
+	EndTime   int64
+}
+
+func (j *DownloadJob) validate() error {
+	if j.Symbol == "" {
+		return fmt.Errorf("binance: download job without symbol")
+	}
+	switch j.DataType {
+	case DownloadDataTypeKlines:
+		if err := j.Interval.Validate(); err != nil {
+			return err
+		}
+	case DownloadDataTypeAggTrades:
+	default:
+		return fmt.Errorf("binance: invalid download data type %q", string(j.DataType))
+	}
+	if j.EndTime <= j.StartTime {
+		return fmt.Errorf("binance: download job %s ends before it starts", j.name())
+	}
+	return nil
+}
+
+// name return the base name of the files of the job
+func (j *DownloadJob) name() string {
+	if j.DataType == DownloadDataTypeKlines {
+		return fmt.Sprintf("%s-%s-%s-%d-%d", j.Symbol, j.DataType, j.Interval, j.StartTime, j.EndTime)
+	}
+	return fmt.Sprintf("%s-%s-%d-%d", j.Symbol, j.DataType, j.StartTime, j.EndTime)
+}
+
+// DownloadGap define missing data between two consecutive rows, or between
+// a bound of the job and the nearest row
+type DownloadGap struct {
+	// StartTime is the first missing open time for klines, or the time of
+	// the trade before the gap for aggregate trades. It is the start time of
+	// the job for a gap before the first row.
+	StartTime int64 `json:"startTime"`
+	// EndTime is the time of the row after the gap, or the end time of the
+	// job for a gap after the last row
+	EndTime int64 `json:"endTime"`
+	// FromID and ToID are the missing aggregate trade IDs, 0 when the bound
+	// of a gap at the start or end of the job is unknown
+	FromID int64 `json:"fromId,omitempty"`
+	ToID   int64 `json:"toId,omitempty"`
+}
+
+// DownloadManifest record the progress of a job, it is saved next to the data
+// file after every page so an interrupted download resumes where it stopped
+type DownloadManifest struct {
+	Symbol    string           `json:"symbol"`
+	DataType  DownloadDataType `json:"dataType"`
+	Interval  KlineInterval    `json:"interval,omitempty"`
+	Format    DownloadFormat   `json:"format"`
+	StartTime int64            `json:"startTime"`
+	EndTime   int64            `json:"endTime"`
+	// LastTime is the open time of the last kline or the time of the last aggregate trade
+	LastTime int64 `json:"lastTime"`
+	// LastID is the ID of the last aggregate trade
+	LastID int64 `json:"lastId,omitempty"`
+	Rows   int64 `json:"rows"`
+	// Size is the size of the data file when the manifest was saved, rows
+	// written after it are discarded on resume
+	Size       int64          `json:"size"`
+	Complete   bool           `json:"complete"`
+	Gaps       []*DownloadGap `json:"gaps"`
+	UpdateTime int64          `json:"updateTime"`
+}
+
+// DownloadResult define the outcome of a job
+type DownloadResult struct {
+	Job      *DownloadJob
+	Path     string
+	Manifest *DownloadManifest
+	Err      error
+}
+
+// Downloader fetch historical market data into local files. Jobs run
+// concurrently and share one rate limiter.
+type Downloader struct {
+	c           *Client
+	dir         string
+	format      DownloadFormat
+	concurrency int
+	limiter     *RateLimiter
+}
+
+// NewDownloader init a downloader writing CSV files in dir. Its jobs share
+// the rate limiter of the client, or a waiting rate limiter with the
+// default limits when the client has none.
+func (c *Client) NewDownloader(dir string) *Downloader {
+	limiter := c.RateLimiter
+	if limiter == nil {
+		limiter = NewRateLimiter(RateLimitPolicyWait)
+	}
+	return &Downloader{c: c, dir: dir, format: DownloadFormatCSV, concurrency: 1, limiter: limiter}
+}
+
+// RateLimiter set the rate limiter shared by the jobs
+func (d *Downloader) RateLimiter(limiter *RateLimiter) *Downloader {
+	d.limiter = limiter
+	return d
+}
+
+// Format set format of the data files
+func (d *Downloader) Format(format DownloadFormat) *Downloader {
+	d.format = format
+	return d
+}
+
+// Concurrency set the number of jobs downloaded at the same time
+func (d *Downloader) Concurrency(concurrency int) *Downloader {
+	d.concurrency = concurrency
+	return d
+}
+
+// Path return the path of the data file of job
+func (d *Downloader) Path(job *DownloadJob) string {
+	return filepath.Join(d.dir, job.name()+"."+string(d.format))
+}
+
+func (d *Downloader) manifestPath(job *DownloadJob) string {
+	return filepath.Join(d.dir, job.name()+".manifest.json")
+}
+
+// Manifest return the saved progress of job, nil if it was never started
+func (d *Downloader) Manifest(job *DownloadJob) (*DownloadManifest, error) {
+	data, err := ioutil.ReadFile(d.manifestPath(job))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := new(DownloadManifest)
+	if err = json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("binance: invalid manifest %s: %w", d.manifestPath(job), err)
+	}
+	if m.Format != d.format {
+		return nil, fmt.Errorf("binance: manifest %s was written as %s", d.manifestPath(job), m.Format)
+	}
+	return m, nil
+}
+
+// saveManifest replace the manifest atomically
+func (d *Downloader) saveManifest(job *DownloadJob, m *DownloadManifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := d.manifestPath(job)
+	if err = ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// Download run the jobs and return their results in the same order. The
+// error is the one of the first failed job, completed jobs are skipped.
+func (d *Downloader) Download(ctx context.Context, jobs ...*DownloadJob) ([]*DownloadResult, error) {
+	results := make([]*DownloadResult, len(jobs))
+	paths := make(map[string]bool, len(jobs))
+	for i, job := range jobs {
+		if err := job.validate(); err != nil {
+			return nil, err
+		}
+		results[i] = &DownloadResult{Job: job, Path: d.Path(job)}
+		if paths[results[i].Path] {
+			return nil, fmt.Errorf("binance: duplicate download job %s", job.name())
+		}
+		paths[results[i].Path] = true
+	}
+	if err := os.MkdirAll(d.dir, 0755); err != nil {
+		return nil, err
+	}
+
+	concurrency := d.concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	queue := make(chan *DownloadResult)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for res := range queue {
+				res.Manifest, res.Err = d.download(ctx, res.Job)
+			}
+		}()
+	}
+	for _, res := range results {
+		queue <- res
+	}
+	close(queue)
+	wg.Wait()
+
+	for _, res := range results {
+		if res.Err != nil {
+			return results, res.Err
+		}
+	}
+	return results, nil
+}
+
+func (d *Downloader) download(ctx context.Context, job *DownloadJob) (*DownloadManifest, error) {
+	m, err := d.Manifest(job)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		m = &DownloadManifest{
+			Symbol:    job.Symbol,
+			DataType:  job.DataType,
+			Interval:  job.Interval,
+			Format:    d.format,
+			StartTime: job.StartTime,
+			EndTime:   job.EndTime,
+		}
+	}
+	if m.Complete {
+		return m, nil
+	}
+
+	f, err := os.OpenFile(d.Path(job), os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return m, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return m, err
+	}
+	if fi.Size() < m.Size {
+		return m, fmt.Errorf("binance: %s is shorter than its manifest", d.Path(job))
+	}
+	if err = f.Truncate(m.Size); err != nil {
+		return m, err
+	}
+	if _, err = f.Seek(m.Size, io.SeekStart); err != nil {
+		return m, err
+	}
+
+	w := newRowWriter(f, d.format)
+	if m.Size == 0 && d.format == DownloadFormatCSV {
+		header := klineCSVHeader
+		if job.DataType == DownloadDataTypeAggTrades {
+			header = aggTradeCSVHeader
+		}
+		if err = w.write(header, nil); err != nil {
+			return m, err
+		}
+	}
+	checkpoint := func(complete bool) error {
+		if err := w.flush(); err != nil {
+			return err
+		}
+		size, err := f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		if err = f.Sync(); err != nil {
+			return err
+		}
+		m.Size = size
+		m.Complete = complete
+		m.UpdateTime = TimeToMillis(time.Now())
+		return d.saveManifest(job, m)
+	}
+
+	if job.DataType == DownloadDataTypeKlines {
+		err = d.downloadKlines(ctx, job, m, w, checkpoint)
+	} else {
+		err = d.downloadAggTrades(ctx, job, m, w, checkpoint)
+	}
+	if err != nil {
+		if e := checkpoint(false); e != nil {
+			d.c.debug("save manifest of %s failed: %v", job.name(), e)
+		}
+		return m, err
+	}
+	return m, checkpoint(true)
+}
+
+func (d *Downloader) downloadKlines(ctx context.Context, job *DownloadJob, m *DownloadManifest,
+	w *rowWriter, checkpoint func(bool) error) error {
+	svc := d.c.NewKlinesService().Symbol(job.Symbol).Interval(job.Interval).
+		StartTime(job.StartTime).EndTime(job.EndTime)
+	if m.Rows > 0 {
+		svc.StartTime(m.LastTime + 1)
+	}
+	it := svc.Iterate(ctx, WithRateLimiter(d.limiter))
+	for it.Next() {
+		k := it.Value()
+		if m.Rows == 0 {
+			// a kline opening at the start time or within the first
+			// interval is expected
+			if nextKlineOpenTime(job.Interval, job.StartTime) <= k.OpenTime {
+				m.Gaps = append(m.Gaps, &DownloadGap{StartTime: job.StartTime, EndTime: k.OpenTime})
+			}
+		} else if next := nextKlineOpenTime(job.Interval, m.LastTime); k.OpenTime > next {
+			m.Gaps = append(m.Gaps, &DownloadGap{StartTime: next, EndTime: k.OpenTime})
+		}
+		err := w.write([]string{
+			strconv.FormatInt(k.OpenTime, 10), k.Open, k.High, k.Low, k.Close, k.Volume,
+			strconv.FormatInt(k.CloseTime, 10), k.QuoteAssetVolume, strconv.FormatInt(k.TradeNum, 10),
+			k.TakerBuyBaseAssetVolume, k.TakerBuyQuoteAssetVolume,
+		}, k)
+		if err != nil {
+			return err
+		}
+		m.LastTime = k.OpenTime
+		m.Rows++
+		if it.PageEnd() {
+			if err = checkpoint(false); err != nil {
+				return err
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	// klines after the last row are missing if they have opened already
+	end := job.EndTime
+	if now := TimeToMillis(time.Now()); now < end {
+		end = now
+	}
+	next := nextKlineOpenTime(job.Interval, job.StartTime)
+	if m.Rows > 0 {
+		next = nextKlineOpenTime(job.Interval, m.LastTime)
+	}
+	if next <= end {
+		start := next
+		if m.Rows == 0 {
+			start = job.StartTime
+		}
+		m.Gaps = append(m.Gaps, &DownloadGap{StartTime: start, EndTime: end})
+	}
+	return nil
+}
+
+func (d *Downloader) downloadAggTrades(ctx context.Context, job *DownloadJob, m *DownloadManifest,
+	w *rowWriter, checkpoint func(bool) error) error {
+	svc := d.c.NewAggTradesService().Symbol(job.Symbol).
+		StartTime(job.StartTime).EndTime(job.EndTime)
+	if m.Rows > 0 {
+		svc.FromID(m.LastID + 1)
+	}
+	it := svc.Iterate(ctx, WithRateLimiter(d.limiter))
+	for it.Next() {
+		t := it.Value()
+		if m.Rows == 0 && t.AggTradeID > 0 {
+			// the trade before the first row must be before the start time
+			prev, err := d.aggTrade(ctx, job.Symbol, t.AggTradeID-1)
+			if err != nil {
+				return err
+			}
+			if prev != nil && prev.Timestamp >= job.StartTime {
+				m.Gaps = append(m.Gaps, &DownloadGap{
+					StartTime: job.StartTime,
+					EndTime:   t.Timestamp,
+					ToID:      t.AggTradeID - 1,
+				})
+			}
+		}
+		if m.Rows > 0 && t.AggTradeID > m.LastID+1 {
+			m.Gaps = append(m.Gaps, &DownloadGap{
+				StartTime: m.LastTime,
+				EndTime:   t.Timestamp,
+				FromID:    m.LastID + 1,
+				ToID:      t.AggTradeID - 1,
+			})
+		}
+		err := w.write([]string{
+			strconv.FormatInt(t.AggTradeID, 10), t.Price, t.Quantity,
+			strconv.FormatInt(t.FirstTradeID, 10), strconv.FormatInt(t.LastTradeID, 10),
+			strconv.FormatInt(t.Timestamp, 10), strconv.FormatBool(t.IsBuyerMaker),
+			strconv.FormatBool(t.IsBestPriceMatch),
+		}, t)
+		if err != nil {
+			return err
+		}
+		m.LastTime = t.Timestamp
+		m.LastID = t.AggTradeID
+		m.Rows++
+		if it.PageEnd() {
+			if err = checkpoint(false); err != nil {
+				return err
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	if m.Rows == 0 {
+		return nil
+	}
+	// the trade after the last row must be after the end time
+	next, err := d.aggTrade(ctx, job.Symbol, m.LastID+1)
+	if err != nil {
+		return err
+	}
+	if next != nil && next.Timestamp <= job.EndTime {
+		m.Gaps = append(m.Gaps, &DownloadGap{
+			StartTime: m.LastTime,
+			EndTime:   job.EndTime,
+			FromID:    m.LastID + 1,
+		})
+	}
+	return nil
+}
+
+// aggTrade return the aggregate trade with the given ID, nil if it does not
+// exist yet
+func (d *Downloader) aggTrade(ctx context.Context, symbol string, id int64) (*AggTrade, error) {
+	res, err := d.c.NewAggTradesService().Symbol(symbol).FromID(id).Limit(1).Do(ctx, WithRateLimiter(d.limiter))
+	if err != nil || len(res) == 0 {
+		return nil, err
+	}
+	return res[0], nil
+}
+
+// nextKlineOpenTime return the open time of the kline following the one
+// opened at openTime, monthly klines follow the calendar
+func nextKlineOpenTime(interval KlineInterval, openTime int64) int64 {
+	if interval == KlineInterval1M {
+		return TimeToMillis(MillisToTime(openTime).AddDate(0, 1, 0))
+	}
+	return openTime + int64(interval.Duration()/time.Millisecond)
+}
+
+// rowWriter write rows as CSV records or JSON lines
+type rowWriter struct {
+	buf *bufio.Writer
+	csv *csv.Writer
+}
+
+func newRowWriter(w io.Writer, format DownloadFormat) *rowWriter {
+	rw := &rowWriter{buf: bufio.NewWriter(w)}
+	if format == DownloadFormatCSV {
+		rw.csv = csv.NewWriter(rw.buf)
+	}
+	return rw
+}
+
+// write the record in CSV or v in JSON, a nil v is only written in CSV
+func (w *rowWriter) write(record []string, v interface{}) error {
+	if w.csv != nil {
+		return w.csv.Write(record)
+	}
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err = w.buf.Write(data); err != nil {
+		return err
+	}
+	return w.buf.WriteByte('\n')
+}
+
+func (w *rowWriter) flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+	return w.buf.Flush()
+}
@@ -0,0 +1,292 @@
+package binance
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type downloaderTestSuite struct {
+	baseTestSuite
+	dir string
+}
+
+func TestDownloader(t *testing.T) {
+	suite.Run(t, new(downloaderTestSuite))
+}
+
+func (s *downloaderTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	dir, err := ioutil.TempDir("", "binance-downloader")
+	s.r().NoError(err)
+	s.dir = dir
+}
+
+func (s *downloaderTestSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+// klinesJSON return n klines of 1m opened from openTime, skipping the ones in skip
+func klinesJSON(openTime int64, n int, skip ...int) []byte {
+	rows := make([]string, 0, n)
+	for i := 0; i < n+len(skip); i++ {
+		skipped := false
+		for _, j := range skip {
+			skipped = skipped || i == j
+		}
+		if skipped {
+			continue
+		}
+		t := openTime + int64(i)*60000
+		rows = append(rows, fmt.Sprintf(`[%d,"1.0","2.0","0.5","1.5","10",%d,"15",3,"4","6","0"]`, t, t+59999))
+	}
+	return []byte("[" + strings.Join(rows, ",") + "]")
+}
+
+func (s *downloaderTestSuite) readLines(path string) []string {
+	f, err := os.Open(path)
+	s.r().NoError(err)
+	defer f.Close()
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines
+}
+
+func (s *downloaderTestSuite) TestKlinesResume() {
+	var startTimes []string
+	s.assertReq(func(r *request) {
+		startTimes = append(startTimes, r.query.Get("startTime"))
+	})
+	// the first page misses the kline at index 10
+	s.mockDoOnce(klinesJSON(0, maxPageLimit, 10), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`{"code":-1003,"msg":"too many requests"}`), nil, http.StatusBadRequest)
+
+	job := &DownloadJob{
+		Symbol:    "LTCBTC",
+		DataType:  DownloadDataTypeKlines,
+		Interval:  KlineInterval1m,
+		StartTime: 0,
+		EndTime:   1100 * 60000,
+	}
+	downloader := s.client.NewDownloader(s.dir)
+	r := s.r()
+	results, err := downloader.Download(newContext(), job)
+	r.Error(err)
+	r.Len(results, 1)
+	r.Equal(err, results[0].Err)
+	m := results[0].Manifest
+	r.False(m.Complete)
+	r.EqualValues(maxPageLimit, m.Rows)
+	r.EqualValues(1000*60000, m.LastTime)
+	r.Len(m.Gaps, 1)
+	r.Equal(&DownloadGap{StartTime: 10 * 60000, EndTime: 11 * 60000}, m.Gaps[0])
+
+	saved, err := downloader.Manifest(job)
+	r.NoError(err)
+	r.Equal(m, saved)
+
+	// rows written after the last checkpoint are discarded
+	f, err := os.OpenFile(results[0].Path, os.O_APPEND|os.O_WRONLY, 0644)
+	r.NoError(err)
+	_, err = f.WriteString("1001,partial")
+	r.NoError(err)
+	f.Close()
+
+	s.mockDoOnce(klinesJSON(1001*60000, 3), nil, http.StatusOK)
+	results, err = downloader.Download(newContext(), job)
+	r.NoError(err)
+	m = results[0].Manifest
+	r.True(m.Complete)
+	r.EqualValues(1003, m.Rows)
+	r.Len(m.Gaps, 2)
+	r.Equal(&DownloadGap{StartTime: 1004 * 60000, EndTime: 1100 * 60000}, m.Gaps[1])
+	r.Equal([]string{"0", "60000001", "60000001"}, startTimes)
+
+	lines := s.readLines(results[0].Path)
+	r.Len(lines, 1004)
+	r.Equal(strings.Join(klineCSVHeader, ","), lines[0])
+	r.Equal("0,1.0,2.0,0.5,1.5,10,59999,15,3,4,6", lines[1])
+	r.True(strings.HasPrefix(lines[1000], "60000000,"))
+	r.True(strings.HasPrefix(lines[1001], "60060000,"))
+
+	// a complete job is not downloaded again
+	_, err = downloader.Download(newContext(), job)
+	r.NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *downloaderTestSuite) TestAggTradesJSONL() {
+	s.mockDoOnce([]byte(`[
+		{"a":1,"p":"1.5","q":"2","f":10,"l":11,"T":1000,"m":true,"M":true},
+		{"a":2,"p":"1.6","q":"3","f":12,"l":12,"T":2000,"m":false,"M":true},
+		{"a":5,"p":"1.7","q":"4","f":15,"l":16,"T":3000,"m":false,"M":true}
+	]`), nil, http.StatusOK)
+	// the trades around the range
+	s.mockDoOnce([]byte(`[{"a":0,"T":900}]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`[{"a":3,"T":3000}]`), nil, http.StatusOK)
+
+	job := &DownloadJob{Symbol: "LTCBTC", DataType: DownloadDataTypeAggTrades, StartTime: 1000, EndTime: 2500}
+	results, err := s.client.NewDownloader(s.dir).Format(DownloadFormatJSONL).Download(newContext(), job)
+	r := s.r()
+	r.NoError(err)
+	m := results[0].Manifest
+	r.True(m.Complete)
+	r.EqualValues(2, m.Rows)
+	r.EqualValues(2, m.LastID)
+	r.Empty(m.Gaps)
+	r.True(strings.HasSuffix(results[0].Path, "LTCBTC-aggTrades-1000-2500.jsonl"))
+
+	lines := s.readLines(results[0].Path)
+	r.Equal([]string{
+		`{"a":1,"p":"1.5","q":"2","f":10,"l":11,"T":1000,"m":true,"M":true}`,
+		`{"a":2,"p":"1.6","q":"3","f":12,"l":12,"T":2000,"m":false,"M":true}`,
+	}, lines)
+}
+
+func (s *downloaderTestSuite) TestAggTradesGap() {
+	s.mockDoOnce([]byte(`[{"a":1,"T":1000},{"a":4,"T":2000}]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`[]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`[{"a":5,"T":2400}]`), nil, http.StatusOK)
+	var fromIDs []string
+	s.assertReq(func(r *request) {
+		fromIDs = append(fromIDs, r.query.Get("fromId"))
+	})
+
+	job := &DownloadJob{Symbol: "LTCBTC", DataType: DownloadDataTypeAggTrades, StartTime: 1000, EndTime: 2500}
+	results, err := s.client.NewDownloader(s.dir).Download(newContext(), job)
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]string{"", "0", "5"}, fromIDs)
+	r.Equal([]*DownloadGap{
+		{StartTime: 1000, EndTime: 2000, FromID: 2, ToID: 3},
+		{StartTime: 2000, EndTime: 2500, FromID: 5},
+	}, results[0].Manifest.Gaps)
+}
+
+func (s *downloaderTestSuite) TestAggTradesGapBeforeFirstRow() {
+	s.mockDoOnce([]byte(`[{"a":3,"T":1500}]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`[{"a":2,"T":1200}]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`[]`), nil, http.StatusOK)
+
+	job := &DownloadJob{Symbol: "LTCBTC", DataType: DownloadDataTypeAggTrades, StartTime: 1000, EndTime: 2500}
+	results, err := s.client.NewDownloader(s.dir).Download(newContext(), job)
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*DownloadGap{{StartTime: 1000, EndTime: 1500, ToID: 2}}, results[0].Manifest.Gaps)
+}
+
+func (s *downloaderTestSuite) TestKlinesGapAtBounds() {
+	s.mockDoOnce(klinesJSON(60000, 3), nil, http.StatusOK)
+
+	job := &DownloadJob{Symbol: "LTCBTC", DataType: DownloadDataTypeKlines, Interval: KlineInterval1m, EndTime: 10 * 60000}
+	results, err := s.client.NewDownloader(s.dir).Download(newContext(), job)
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*DownloadGap{
+		{StartTime: 0, EndTime: 60000},
+		{StartTime: 4 * 60000, EndTime: 10 * 60000},
+	}, results[0].Manifest.Gaps)
+
+	// nothing downloaded at all
+	s.mockDoOnce([]byte(`[]`), nil, http.StatusOK)
+	job.StartTime = 30000
+	results, err = s.client.NewDownloader(s.dir).Download(newContext(), job)
+	r.NoError(err)
+	r.Equal([]*DownloadGap{{StartTime: 30000, EndTime: 10 * 60000}}, results[0].Manifest.Gaps)
+}
+
+func (s *downloaderTestSuite) TestConcurrentJobs() {
+	for i := 0; i < 3; i++ {
+		s.mockDoOnce(klinesJSON(0, 2), nil, http.StatusOK)
+	}
+
+	var jobs []*DownloadJob
+	for _, symbol := range []string{"LTCBTC", "ETHBTC", "BNBBTC"} {
+		jobs = append(jobs, &DownloadJob{
+			Symbol:   symbol,
+			DataType: DownloadDataTypeKlines,
+			Interval: KlineInterval1m,
+			EndTime:  60000,
+		})
+	}
+	results, err := s.client.NewDownloader(s.dir).Concurrency(2).Download(newContext(), jobs...)
+	r := s.r()
+	r.NoError(err)
+	r.Len(results, 3)
+	for i, res := range results {
+		r.Equal(jobs[i], res.Job)
+		r.EqualValues(2, res.Manifest.Rows)
+		r.Contains(res.Path, jobs[i].Symbol)
+	}
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *downloaderTestSuite) TestSharedRateLimiter() {
+	r := s.r()
+	d := s.client.NewDownloader(s.dir)
+	r.NotNil(d.limiter)
+	r.Nil(s.client.RateLimiter)
+
+	for i := 0; i < 2; i++ {
+		s.mockDoOnce(klinesJSON(0, 2), nil, http.StatusOK)
+	}
+	var jobs []*DownloadJob
+	for _, symbol := range []string{"LTCBTC", "ETHBTC", "BNBBTC"} {
+		jobs = append(jobs, &DownloadJob{
+			Symbol:   symbol,
+			DataType: DownloadDataTypeKlines,
+			Interval: KlineInterval1m,
+			EndTime:  60000,
+		})
+	}
+	d.RateLimiter(NewRateLimiter(RateLimitPolicyFailFast, &ExchangeInfoRateLimit{
+		RateLimitType: "REQUEST_WEIGHT",
+		Interval:      "MINUTE",
+		Limit:         2,
+	}))
+	_, err := d.Concurrency(3).Download(newContext(), jobs...)
+	r.Equal(ErrRateLimitExceeded, err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *downloaderTestSuite) TestCancel() {
+	ctx, cancel := context.WithCancel(newContext())
+	cancel()
+	job := &DownloadJob{Symbol: "LTCBTC", DataType: DownloadDataTypeKlines, Interval: KlineInterval1m, EndTime: 60000}
+	results, err := s.client.NewDownloader(s.dir).Download(ctx, job)
+	r := s.r()
+	r.Equal(context.Canceled, err)
+	r.False(results[0].Manifest.Complete)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *downloaderTestSuite) TestInvalidJobs() {
+	downloader := s.client.NewDownloader(s.dir)
+	r := s.r()
+	_, err := downloader.Download(newContext(), &DownloadJob{Symbol: "LTCBTC", DataType: DownloadDataTypeKlines, Interval: "2m", EndTime: 1})
+	r.Error(err)
+	_, err = downloader.Download(newContext(), &DownloadJob{Symbol: "LTCBTC", DataType: "trades", EndTime: 1})
+	r.Error(err)
+	_, err = downloader.Download(newContext(), &DownloadJob{Symbol: "LTCBTC", DataType: DownloadDataTypeAggTrades, StartTime: 2, EndTime: 1})
+	r.Error(err)
+	job := &DownloadJob{Symbol: "LTCBTC", DataType: DownloadDataTypeAggTrades, EndTime: 1}
+	_, err = downloader.Download(newContext(), job, job)
+	r.Error(err)
+}
+
+func (s *downloaderTestSuite) TestNextKlineOpenTime() {
+	r := s.r()
+	r.EqualValues(60000, nextKlineOpenTime(KlineInterval1m, 0))
+	jan := TimeToMillis(MillisToTime(0).AddDate(50, 0, 0))
+	r.Equal(MillisToTime(jan).AddDate(0, 1, 0), MillisToTime(nextKlineOpenTime(KlineInterval1M, jan)))
+}
@@ -0,0 +1,171 @@
+package binance
+
+import (
+	"fmt"
+	"time"
+)
+
+// OrderStatusType define order status type
+type OrderStatusType string
+
+// ExecutionType define the type of an execution report
+type ExecutionType string
+
+// RejectReason define the reason of a rejected order in an execution report
+type RejectReason string
+
+// SymbolStatus define trading status of a symbol
+type SymbolStatus string
+
+// KlineInterval define kline interval
+type KlineInterval string
+
+// Typed enums
+const (
+	OrderStatusTypeNew             OrderStatusType = "NEW"
+	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
+	OrderStatusTypeFilled          OrderStatusType = "FILLED"
+	OrderStatusTypeCanceled        OrderStatusType = "CANCELED"
+	OrderStatusTypePendingCancel   OrderStatusType = "PENDING_CANCEL"
+	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
+	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
+	OrderStatusTypeExpiredInMatch  OrderStatusType = "EXPIRED_IN_MATCH"
+
+	ExecutionTypeNew             ExecutionType = "NEW"
+	ExecutionTypeCanceled        ExecutionType = "CANCELED"
+	ExecutionTypeReplaced        ExecutionType = "REPLACED"
+	ExecutionTypeRejected        ExecutionType = "REJECTED"
+	ExecutionTypeTrade           ExecutionType = "TRADE"
+	ExecutionTypeExpired         ExecutionType = "EXPIRED"
+	ExecutionTypeTradePrevention ExecutionType = "TRADE_PREVENTION"
+
+	RejectReasonNone                         RejectReason = "NONE"
+	RejectReasonUnknownInstrument            RejectReason = "UNKNOWN_INSTRUMENT"
+	RejectReasonMarketClosed                 RejectReason = "MARKET_CLOSED"
+	RejectReasonPriceQtyExceedHardLimits     RejectReason = "PRICE_QTY_EXCEED_HARD_LIMITS"
+	RejectReasonUnknownOrder                 RejectReason = "UNKNOWN_ORDER"
+	RejectReasonDuplicateOrder               RejectReason = "DUPLICATE_ORDER"
+	RejectReasonUnknownAccount               RejectReason = "UNKNOWN_ACCOUNT"
+	RejectReasonInsufficientBalance          RejectReason = "INSUFFICIENT_BALANCE"
+	RejectReasonAccountInactive              RejectReason = "ACCOUNT_INACTIVE"
+	RejectReasonAccountCannotSettle          RejectReason = "ACCOUNT_CANNOT_SETTLE"
+	RejectReasonOrderWouldTriggerImmediately RejectReason = "ORDER_WOULD_TRIGGER_IMMEDIATELY"
+
+	SymbolStatusPreTrading   SymbolStatus = "PRE_TRADING"
+	SymbolStatusTrading      SymbolStatus = "TRADING"
+	SymbolStatusPostTrading  SymbolStatus = "POST_TRADING"
+	SymbolStatusEndOfDay     SymbolStatus = "END_OF_DAY"
+	SymbolStatusHalt         SymbolStatus = "HALT"
+	SymbolStatusAuctionMatch SymbolStatus = "AUCTION_MATCH"
+	SymbolStatusBreak        SymbolStatus = "BREAK"
+
+	KlineInterval1s  KlineInterval = "1s"
+	KlineInterval1m  KlineInterval = "1m"
+	KlineInterval3m  KlineInterval = "3m"
+	KlineInterval5m  KlineInterval = "5m"
+	KlineInterval15m KlineInterval = "15m"
+	KlineInterval30m KlineInterval = "30m"
+	KlineInterval1h  KlineInterval = "1h"
+	KlineInterval2h  KlineInterval = "2h"
+	KlineInterval4h  KlineInterval = "4h"
+	KlineInterval6h  KlineInterval = "6h"
+	KlineInterval8h  KlineInterval = "8h"
+	KlineInterval12h KlineInterval = "12h"
+	KlineInterval1d  KlineInterval = "1d"
+	KlineInterval3d  KlineInterval = "3d"
+	KlineInterval1w  KlineInterval = "1w"
+	KlineInterval1M  KlineInterval = "1M"
+)
+
+// IsValid check if s is a known order status
+func (s OrderStatusType) IsValid() bool {
+	switch s {
+	case OrderStatusTypeNew, OrderStatusTypePartiallyFilled, OrderStatusTypeFilled,
+		OrderStatusTypeCanceled, OrderStatusTypePendingCancel, OrderStatusTypeRejected,
+		OrderStatusTypeExpired, OrderStatusTypeExpiredInMatch:
+		return true
+	}
+	return false
+}
+
+// IsFinal check if an order with status s can not change anymore
+func (s OrderStatusType) IsFinal() bool {
+	switch s {
+	case OrderStatusTypeFilled, OrderStatusTypeCanceled, OrderStatusTypeRejected,
+		OrderStatusTypeExpired, OrderStatusTypeExpiredInMatch:
+		return true
+	}
+	return false
+}
+
+// IsOpen check if an order with status s is in the order book
+func (s OrderStatusType) IsOpen() bool {
+	return s == OrderStatusTypeNew || s == OrderStatusTypePartiallyFilled
+}
+
+// IsValid check if t is a known execution type
+func (t ExecutionType) IsValid() bool {
+	switch t {
+	case ExecutionTypeNew, ExecutionTypeCanceled, ExecutionTypeReplaced, ExecutionTypeRejected,
+		ExecutionTypeTrade, ExecutionTypeExpired, ExecutionTypeTradePrevention:
+		return true
+	}
+	return false
+}
+
+// IsValid check if s is a known symbol status
+func (s SymbolStatus) IsValid() bool {
+	switch s {
+	case SymbolStatusPreTrading, SymbolStatusTrading, SymbolStatusPostTrading, SymbolStatusEndOfDay,
+		SymbolStatusHalt, SymbolStatusAuctionMatch, SymbolStatusBreak:
+		return true
+	}
+	return false
+}
+
+// IsTrading check if orders can be placed on a symbol with status s
+func (s SymbolStatus) IsTrading() bool {
+	return s == SymbolStatusTrading
+}
+
+var klineIntervalDurations = map[KlineInterval]time.Duration{
+	KlineInterval1s:  time.Second,
+	KlineInterval1m:  time.Minute,
+	KlineInterval3m:  3 * time.Minute,
+	KlineInterval5m:  5 * time.Minute,
+	KlineInterval15m: 15 * time.Minute,
+	KlineInterval30m: 30 * time.Minute,
+	KlineInterval1h:  time.Hour,
+	KlineInterval2h:  2 * time.Hour,
+	KlineInterval4h:  4 * time.Hour,
+	KlineInterval6h:  6 * time.Hour,
+	KlineInterval8h:  8 * time.Hour,
+	KlineInterval12h: 12 * time.Hour,
+	KlineInterval1d:  24 * time.Hour,
+	KlineInterval3d:  3 * 24 * time.Hour,
+	KlineInterval1w:  7 * 24 * time.Hour,
+	KlineInterval1M:  30 * 24 * time.Hour,
+}
+
+// ParseKlineInterval parse an interval like 15m and check it is supported
+func ParseKlineInterval(s string) (KlineInterval, error) {
+	interval := KlineInterval(s)
+	if err := interval.Validate(); err != nil {
+		return "", err
+	}
+	return interval, nil
+}
+
+// Validate return an error if i is not a supported interval
+func (i KlineInterval) Validate() error {
+	if _, ok := klineIntervalDurations[i]; !ok {
+		return fmt.Errorf("binance: invalid kline interval %q", string(i))
+	}
+	return nil
+}
+
+// Duration return the length of the interval, 1M is counted as 30 days
+// while monthly klines follow the calendar. It returns 0 for invalid intervals.
+func (i KlineInterval) Duration() time.Duration {
+	return klineIntervalDurations[i]
+}
@@ -0,0 +1,149 @@
+package binance
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type enumsTestSuite struct {
+	baseTestSuite
+}
+
+func TestEnums(t *testing.T) {
+	suite.Run(t, new(enumsTestSuite))
+}
+
+func (s *enumsTestSuite) TestOrderStatus() {
+	r := s.r()
+	r.True(OrderStatusTypeFilled.IsFinal())
+	r.True(OrderStatusTypeExpiredInMatch.IsFinal())
+	r.False(OrderStatusTypePartiallyFilled.IsFinal())
+	r.False(OrderStatusTypePendingCancel.IsFinal())
+	r.True(OrderStatusTypeNew.IsOpen())
+	r.False(OrderStatusTypeCanceled.IsOpen())
+	r.True(OrderStatusTypeRejected.IsValid())
+	r.False(OrderStatusType("DONE").IsValid())
+}
+
+func (s *enumsTestSuite) TestSymbolStatus() {
+	r := s.r()
+	r.True(SymbolStatusTrading.IsTrading())
+	r.False(SymbolStatusBreak.IsTrading())
+	r.True(SymbolStatusHalt.IsValid())
+	r.False(SymbolStatus("HALTED").IsValid())
+	r.True(ExecutionTypeTrade.IsValid())
+	r.False(ExecutionType("FILL").IsValid())
+}
+
+func (s *enumsTestSuite) TestKlineInterval() {
+	r := s.r()
+	interval, err := ParseKlineInterval("15m")
+	r.NoError(err)
+	r.Equal(KlineInterval15m, interval)
+	r.Equal(15*time.Minute, interval.Duration())
+	r.Equal(7*24*time.Hour, KlineInterval1w.Duration())
+
+	_, err = ParseKlineInterval("15min")
+	r.EqualError(err, `binance: invalid kline interval "15min"`)
+	r.Zero(KlineInterval("15min").Duration())
+	// intervals are case sensitive, 1m is a minute and 1M a month
+	r.NotEqual(KlineInterval1m.Duration(), KlineInterval1M.Duration())
+}
+
+func (s *enumsTestSuite) TestInvalidKlineIntervalIsNotSent() {
+	_, err := s.client.NewKlinesService().Symbol("LTCBTC").Interval("15min").Do(newContext())
+	s.r().Error(err)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+
+	err = WsKlineServe("LTCBTC", "15min", nil, nil).Connect()
+	s.r().EqualError(err, `binance: invalid kline interval "15min"`)
+}
+
+func (s *enumsTestSuite) TestDecodeTypedResponses() {
+	r := s.r()
+	order := new(Order)
+	r.NoError(json.Unmarshal([]byte(`{"status":"PARTIALLY_FILLED","type":"LIMIT","side":"BUY","timeInForce":"IOC"}`), order))
+	r.Equal(OrderStatusTypePartiallyFilled, order.Status)
+	r.Equal(OrderTypeLimit, order.Type)
+	r.Equal(SideTypeBuy, order.Side)
+	r.Equal(TimeInForceIOC, order.TimeInForce)
+
+	symbol := new(ExchangeInfoSymbol)
+	r.NoError(json.Unmarshal([]byte(`{"status":"BREAK","orderTypes":["LIMIT","MARKET"]}`), symbol))
+	r.Equal(SymbolStatusBreak, symbol.Status)
+	r.Equal([]OrderType{OrderTypeLimit, OrderTypeMarket}, symbol.OrderTypes)
+}
+
+func (s *enumsTestSuite) TestDecodeExecutionReport() {
+	data := []byte(`{
+        "e": "executionReport",
+        "E": 1499405658658,
+        "s": "ETHBTC",
+        "c": "mUvoqJxFIILMdfAW5iGSOW",
+        "S": "BUY",
+        "o": "LIMIT",
+        "f": "GTC",
+        "q": "1.00000000",
+        "p": "0.10264410",
+        "P": "0.00000000",
+        "F": "0.00000000",
+        "g": -1,
+        "C": "",
+        "x": "TRADE",
+        "X": "FILLED",
+        "r": "NONE",
+        "i": 4293153,
+        "l": "1.00000000",
+        "z": "1.00000000",
+        "L": "0.10264410",
+        "n": "0.00010264",
+        "N": "ETH",
+        "T": 1499405658657,
+        "t": 42,
+        "I": 8641984,
+        "w": false,
+        "m": true,
+        "M": false,
+        "O": 1499405658657,
+        "Z": "0.10264410",
+        "Y": "0.10264410",
+        "Q": "0.00000000"
+    }`)
+	event := new(WsExecutionReportEvent)
+	r := s.r()
+	r.NoError(json.Unmarshal(data, event))
+	r.Equal(&WsExecutionReportEvent{
+		Event:                    "executionReport",
+		Time:                     1499405658658,
+		Symbol:                   "ETHBTC",
+		ClientOrderID:            "mUvoqJxFIILMdfAW5iGSOW",
+		Side:                     SideTypeBuy,
+		Type:                     OrderTypeLimit,
+		TimeInForce:              TimeInForceGTC,
+		Quantity:                 "1.00000000",
+		Price:                    "0.10264410",
+		StopPrice:                "0.00000000",
+		IcebergQuantity:          "0.00000000",
+		OrderListID:              -1,
+		ExecutionType:            ExecutionTypeTrade,
+		Status:                   OrderStatusTypeFilled,
+		RejectReason:             RejectReasonNone,
+		OrderID:                  4293153,
+		LastExecutedQuantity:     "1.00000000",
+		ExecutedQuantity:         "1.00000000",
+		LastExecutedPrice:        "0.10264410",
+		Commission:               "0.00010264",
+		CommissionAsset:          "ETH",
+		TransactionTime:          1499405658657,
+		TradeID:                  42,
+		IsMaker:                  true,
+		CreateTime:               1499405658657,
+		CummulativeQuoteQuantity: "0.10264410",
+		LastQuoteQuantity:        "0.10264410",
+		QuoteOrderQuantity:       "0.00000000",
+	}, event)
+	r.True(event.Status.IsFinal())
+}
@@ -1,13 +1,77 @@
 package binance
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// API error codes documented by binance
+const (
+	// 10xx - General server or network issues
+	ErrCodeUnknown                 int64 = -1000
+	ErrCodeDisconnected            int64 = -1001
+	ErrCodeUnauthorized            int64 = -1002
+	ErrCodeTooManyRequests         int64 = -1003
+	ErrCodeUnexpectedResponse      int64 = -1006
+	ErrCodeTimeout                 int64 = -1007
+	ErrCodeInvalidMessage          int64 = -1013
+	ErrCodeUnknownOrderComposition int64 = -1014
+	ErrCodeTooManyOrders           int64 = -1015
+	ErrCodeServiceShuttingDown     int64 = -1016
+	ErrCodeUnsupportedOperation    int64 = -1020
+	ErrCodeInvalidTimestamp        int64 = -1021
+	ErrCodeInvalidSignature        int64 = -1022
+
+	// 11xx - Request issues
+	ErrCodeIllegalChars           int64 = -1100
+	ErrCodeTooManyParameters      int64 = -1101
+	ErrCodeMandatoryParamEmpty    int64 = -1102
+	ErrCodeUnknownParam           int64 = -1103
+	ErrCodeUnreadParameters       int64 = -1104
+	ErrCodeParamEmpty             int64 = -1105
+	ErrCodeParamNotRequired       int64 = -1106
+	ErrCodeBadPrecision           int64 = -1111
+	ErrCodeNoDepth                int64 = -1112
+	ErrCodeTIFNotRequired         int64 = -1114
+	ErrCodeInvalidTIF             int64 = -1115
+	ErrCodeInvalidOrderType       int64 = -1116
+	ErrCodeInvalidSide            int64 = -1117
+	ErrCodeEmptyNewClientOrderID  int64 = -1118
+	ErrCodeEmptyOrigClientOrderID int64 = -1119
+	ErrCodeBadInterval            int64 = -1120
+	ErrCodeBadSymbol              int64 = -1121
+	ErrCodeInvalidListenKey       int64 = -1125
+	ErrCodeMoreThanXXHours        int64 = -1127
+	ErrCodeOptionalParamsBadCombo int64 = -1128
+	ErrCodeInvalidParameter       int64 = -1130
+
+	// 20xx - Processing issues
+	ErrCodeNewOrderRejected          int64 = -2010
+	ErrCodeCancelRejected            int64 = -2011
+	ErrCodeNoSuchOrder               int64 = -2013
+	ErrCodeBadAPIKeyFormat           int64 = -2014
+	ErrCodeRejectedAPIKey            int64 = -2015
+	ErrCodeNoTradingWindow           int64 = -2016
+	ErrCodeMarginInsufficientBalance int64 = -2019
+	ErrCodeCancelReplacePartial      int64 = -2021
+	ErrCodeCancelReplaceFailed       int64 = -2022
 )
 
 // APIError define API error when response status is 4xx or 5xx
 type APIError struct {
 	Code    int64  `json:"code"`
 	Message string `json:"msg"`
+	// StatusCode is the HTTP status code of the response
+	StatusCode int `json:"-"`
+	// Header is the header of the response
+	Header http.Header `json:"-"`
+	// Data is the detail some endpoints like cancelReplace attach to errors
+	Data json.RawMessage `json:"data,omitempty"`
 }
 
 // Error return error code and message
@@ -15,8 +79,134 @@ func (e APIError) Error() string {
 	return fmt.Sprintf("<APIError> code=%d, msg=%s", e.Code, e.Message)
 }
 
+// Is report whether the error matches target, which is either an error
+// class like ErrNoSuchOrder or an APIError with the same code
+func (e APIError) Is(target error) bool {
+	switch t := target.(type) {
+	case *apiErrorClass:
+		return t.match(e.Code)
+	case *APIError:
+		return t.Code == e.Code
+	case APIError:
+		return t.Code == e.Code
+	}
+	return false
+}
+
+// apiErrorClass match API errors by code, see the Err* variables
+type apiErrorClass struct {
+	name  string
+	match func(code int64) bool
+}
+
+func (c *apiErrorClass) Error() string {
+	return "binance: " + c.name
+}
+
+func newAPIErrorClass(name string, codes ...int64) *apiErrorClass {
+	return &apiErrorClass{
+		name: name,
+		match: func(code int64) bool {
+			for _, c := range codes {
+				if c == code {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
+
+func newAPIErrorRange(name string, from, to int64) *apiErrorClass {
+	return &apiErrorClass{
+		name: name,
+		match: func(code int64) bool {
+			return code <= from && code >= to
+		},
+	}
+}
+
+// Error classes to use with errors.Is
+var (
+	// ErrServerOrNetwork match the -10xx codes
+	ErrServerOrNetwork error = newAPIErrorRange("server or network issue", -1000, -1099)
+	// ErrRequestIssue match the -11xx codes
+	ErrRequestIssue error = newAPIErrorRange("request issue", -1100, -1199)
+	// ErrNewOrderRejected match the -2010 code
+	ErrNewOrderRejected error = newAPIErrorClass("new order rejected", ErrCodeNewOrderRejected)
+	// ErrCancelRejected match the -2011 code
+	ErrCancelRejected error = newAPIErrorClass("cancel rejected", ErrCodeCancelRejected)
+	// ErrNoSuchOrder match the -2013 code
+	ErrNoSuchOrder error = newAPIErrorClass("no such order", ErrCodeNoSuchOrder)
+	// ErrBadAPIKeyFormat match the -2014 code
+	ErrBadAPIKeyFormat error = newAPIErrorClass("API key format invalid", ErrCodeBadAPIKeyFormat)
+	// ErrRejectedAPIKey match the -2015 code
+	ErrRejectedAPIKey error = newAPIErrorClass("invalid API key, IP, or permissions for action", ErrCodeRejectedAPIKey)
+)
+
 // IsAPIError check if e is an API error
 func IsAPIError(e error) bool {
-	_, ok := e.(*APIError)
+	_, ok := asAPIError(e)
 	return ok
 }
+
+// asAPIError find the API error in the chain of err, in pointer or value form
+func asAPIError(err error) (*APIError, bool) {
+	var ptr *APIError
+	if errors.As(err, &ptr) && ptr != nil {
+		return ptr, true
+	}
+	var val APIError
+	if errors.As(err, &val) {
+		return &val, true
+	}
+	return nil, false
+}
+
+// IsRetryable check if the request that failed with err may succeed if sent again
+func IsRetryable(err error) bool {
+	// the caller gave up, even when the error is wrapped in a net.Error
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var rateLimitedErr *RateLimitedError
+	if errors.As(err, &rateLimitedErr) {
+		return false
+	}
+	if apiErr, ok := asAPIError(err); ok {
+		if isRateLimitedStatus(apiErr.StatusCode) {
+			return false
+		}
+		return apiErr.StatusCode >= http.StatusInternalServerError || retrySafeCodes[apiErr.Code]
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// IsAuthError check if err is caused by an invalid API key, signature or permission
+func IsAuthError(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	switch apiErr.Code {
+	case ErrCodeUnauthorized, ErrCodeInvalidSignature, ErrCodeBadAPIKeyFormat, ErrCodeRejectedAPIKey:
+		return true
+	}
+	return apiErr.StatusCode == http.StatusUnauthorized
+}
+
+// IsInsufficientBalance check if err is an order rejected for insufficient balance
+func IsInsufficientBalance(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	switch apiErr.Code {
+	case ErrCodeMarginInsufficientBalance:
+		return true
+	case ErrCodeNewOrderRejected:
+		return strings.Contains(strings.ToLower(apiErr.Message), "insufficient balance")
+	}
+	return false
+}
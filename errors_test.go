@@ -0,0 +1,119 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type errorsTestSuite struct {
+	baseTestSuite
+}
+
+func TestErrors(t *testing.T) {
+	suite.Run(t, new(errorsTestSuite))
+}
+
+func (s *errorsTestSuite) TestAPIErrorFromResponse() {
+	s.client.Client.do = s.client.do
+	res := newHTTPResponse([]byte(`{"code":-2013,"msg":"Order does not exist."}`), http.StatusBadRequest)
+	res.Header = http.Header{"X-Mbx-Used-Weight-1m": []string{"3"}}
+	s.client.On("do", anyHTTPRequest()).Return(res, nil).Once()
+
+	_, err := s.client.NewGetOrderService().Symbol("LTCBTC").OrderID(1).Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.True(IsAPIError(err))
+	var apiErr *APIError
+	r.True(errors.As(err, &apiErr))
+	r.Equal(ErrCodeNoSuchOrder, apiErr.Code)
+	r.Equal("Order does not exist.", apiErr.Message)
+	r.Equal(http.StatusBadRequest, apiErr.StatusCode)
+	r.Equal("3", apiErr.Header.Get("X-MBX-USED-WEIGHT-1M"))
+	r.True(errors.Is(err, ErrNoSuchOrder))
+	r.False(errors.Is(err, ErrCancelRejected))
+	r.False(errors.Is(err, ErrRequestIssue))
+}
+
+func (s *errorsTestSuite) TestNonJSONErrorBody() {
+	s.mockDoOnce([]byte(`<html>Bad Gateway</html>`), nil, http.StatusBadGateway)
+
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	r := s.r()
+	apiErr, ok := err.(*APIError)
+	r.True(ok)
+	r.Equal(http.StatusBadGateway, apiErr.StatusCode)
+	r.Equal("<html>Bad Gateway</html>", apiErr.Message)
+	r.True(IsRetryable(err))
+}
+
+func (s *errorsTestSuite) TestValueAPIError() {
+	var err error = APIError{Code: ErrCodeInvalidTimestamp, Message: "timestamp"}
+	r := s.r()
+	r.True(IsAPIError(err))
+	r.True(IsAPIError(fmt.Errorf("wrapped: %w", err)))
+	r.True(errors.Is(err, ErrServerOrNetwork))
+	r.True(errors.Is(err, &APIError{Code: ErrCodeInvalidTimestamp}))
+	r.False(IsAPIError(errors.New("plain")))
+	r.False(IsAPIError(nil))
+}
+
+func (s *errorsTestSuite) TestErrorClasses() {
+	r := s.r()
+	r.True(errors.Is(&APIError{Code: ErrCodeUnknown}, ErrServerOrNetwork))
+	r.True(errors.Is(&APIError{Code: ErrCodeInvalidSignature}, ErrServerOrNetwork))
+	r.True(errors.Is(&APIError{Code: ErrCodeBadSymbol}, ErrRequestIssue))
+	r.False(errors.Is(&APIError{Code: ErrCodeBadSymbol}, ErrServerOrNetwork))
+	r.True(errors.Is(&APIError{Code: ErrCodeNewOrderRejected}, ErrNewOrderRejected))
+	r.True(errors.Is(&APIError{Code: ErrCodeCancelRejected}, ErrCancelRejected))
+	r.True(errors.Is(&APIError{Code: ErrCodeBadAPIKeyFormat}, ErrBadAPIKeyFormat))
+	r.True(errors.Is(&APIError{Code: ErrCodeRejectedAPIKey}, ErrRejectedAPIKey))
+
+	rateLimitedErr := &RateLimitedError{
+		StatusCode: http.StatusTooManyRequests,
+		APIError:   &APIError{Code: ErrCodeTooManyRequests},
+	}
+	r.True(errors.Is(rateLimitedErr, ErrServerOrNetwork))
+}
+
+func (s *errorsTestSuite) TestIsRetryable() {
+	r := s.r()
+	r.True(IsRetryable(&APIError{Code: ErrCodeUnknown, StatusCode: http.StatusInternalServerError}))
+	r.True(IsRetryable(&APIError{Code: ErrCodeTimeout, StatusCode: http.StatusBadRequest}))
+	r.False(IsRetryable(&APIError{Code: ErrCodeBadSymbol, StatusCode: http.StatusBadRequest}))
+	r.False(IsRetryable(&RateLimitedError{StatusCode: http.StatusTeapot}))
+	r.True(IsRetryable(&net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	r.False(IsRetryable(errors.New("plain")))
+	r.False(IsRetryable(&url.Error{Op: "Get", URL: "https://api.binance.com", Err: context.Canceled}))
+	r.False(IsRetryable(&url.Error{Op: "Get", URL: "https://api.binance.com", Err: context.DeadlineExceeded}))
+}
+
+func (s *errorsTestSuite) TestIsAuthError() {
+	r := s.r()
+	r.True(IsAuthError(&APIError{Code: ErrCodeRejectedAPIKey}))
+	r.True(IsAuthError(&APIError{Code: ErrCodeBadAPIKeyFormat}))
+	r.True(IsAuthError(&APIError{Code: ErrCodeInvalidSignature}))
+	r.True(IsAuthError(&APIError{StatusCode: http.StatusUnauthorized}))
+	r.False(IsAuthError(&APIError{Code: ErrCodeNoSuchOrder}))
+	r.False(IsAuthError(errors.New("plain")))
+}
+
+func (s *errorsTestSuite) TestIsInsufficientBalance() {
+	r := s.r()
+	r.True(IsInsufficientBalance(&APIError{
+		Code:    ErrCodeNewOrderRejected,
+		Message: "Account has insufficient balance for requested action.",
+	}))
+	r.False(IsInsufficientBalance(&APIError{
+		Code:    ErrCodeNewOrderRejected,
+		Message: "Market is closed.",
+	}))
+	r.True(IsInsufficientBalance(&APIError{Code: ErrCodeMarginInsufficientBalance}))
+	r.False(IsInsufficientBalance(errors.New("insufficient balance")))
+}
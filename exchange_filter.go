@@ -0,0 +1,343 @@
+package binance
+
+import (
+	"encoding/json"
+)
+
+// FilterType define symbol and exchange filter type
+type FilterType string
+
+// Filter types
+const (
+	FilterTypePrice                    FilterType = "PRICE_FILTER"
+	FilterTypePercentPrice             FilterType = "PERCENT_PRICE"
+	FilterTypeLotSize                  FilterType = "LOT_SIZE"
+	FilterTypeMinNotional              FilterType = "MIN_NOTIONAL"
+	FilterTypeIcebergParts             FilterType = "ICEBERG_PARTS"
+	FilterTypeMarketLotSize            FilterType = "MARKET_LOT_SIZE"
+	FilterTypeMaxNumOrders             FilterType = "MAX_NUM_ORDERS"
+	FilterTypeMaxNumAlgoOrders         FilterType = "MAX_NUM_ALGO_ORDERS"
+	FilterTypeMaxNumIcebergOrders      FilterType = "MAX_NUM_ICEBERG_ORDERS"
+	FilterTypeExchangeMaxNumOrders     FilterType = "EXCHANGE_MAX_NUM_ORDERS"
+	FilterTypeExchangeMaxNumAlgoOrders FilterType = "EXCHANGE_MAX_NUM_ALGO_ORDERS"
+)
+
+// Filter define a typed symbol or exchange filter, use a type switch on the
+// concrete types like *PriceFilter or *LotSizeFilter
+type Filter interface {
+	FilterType() FilterType
+}
+
+// PriceFilter define the price rules of a symbol
+type PriceFilter struct {
+	MinPrice Decimal `json:"minPrice"`
+	MaxPrice Decimal `json:"maxPrice"`
+	TickSize Decimal `json:"tickSize"`
+}
+
+// PercentPriceFilter define the valid price range relative to the average price
+type PercentPriceFilter struct {
+	MultiplierUp   Decimal `json:"multiplierUp"`
+	MultiplierDown Decimal `json:"multiplierDown"`
+	AvgPriceMins   int     `json:"avgPriceMins"`
+}
+
+// LotSizeFilter define the quantity rules of a symbol
+type LotSizeFilter struct {
+	MinQuantity Decimal `json:"minQty"`
+	MaxQuantity Decimal `json:"maxQty"`
+	StepSize    Decimal `json:"stepSize"`
+}
+
+// MarketLotSizeFilter define the quantity rules of market orders
+type MarketLotSizeFilter struct {
+	MinQuantity Decimal `json:"minQty"`
+	MaxQuantity Decimal `json:"maxQty"`
+	StepSize    Decimal `json:"stepSize"`
+}
+
+// MinNotionalFilter define the minimum price * quantity of an order
+type MinNotionalFilter struct {
+	MinNotional   Decimal `json:"minNotional"`
+	ApplyToMarket bool    `json:"applyToMarket"`
+	AvgPriceMins  int     `json:"avgPriceMins"`
+}
+
+// IcebergPartsFilter define the maximum number of parts of an iceberg order
+type IcebergPartsFilter struct {
+	Limit int `json:"limit"`
+}
+
+// MaxNumOrdersFilter define the maximum number of open orders on a symbol
+type MaxNumOrdersFilter struct {
+	MaxNumOrders int `json:"maxNumOrders"`
+}
+
+// MaxNumAlgoOrdersFilter define the maximum number of open stop orders on a symbol
+type MaxNumAlgoOrdersFilter struct {
+	MaxNumAlgoOrders int `json:"maxNumAlgoOrders"`
+}
+
+// MaxNumIcebergOrdersFilter define the maximum number of open iceberg orders on a symbol
+type MaxNumIcebergOrdersFilter struct {
+	MaxNumIcebergOrders int `json:"maxNumIcebergOrders"`
+}
+
+// ExchangeMaxNumOrdersFilter define the maximum number of open orders on the exchange
+type ExchangeMaxNumOrdersFilter struct {
+	MaxNumOrders int `json:"maxNumOrders"`
+}
+
+// ExchangeMaxNumAlgoOrdersFilter define the maximum number of open stop orders on the exchange
+type ExchangeMaxNumAlgoOrdersFilter struct {
+	MaxNumAlgoOrders int `json:"maxNumAlgoOrders"`
+}
+
+// UnknownFilter keep a filter of a type this package does not know
+type UnknownFilter struct {
+	Type FilterType
+	Raw  json.RawMessage
+}
+
+// FilterType implement Filter
+func (f *PriceFilter) FilterType() FilterType {
+	return FilterTypePrice
+}
+
+// FilterType implement Filter
+func (f *PercentPriceFilter) FilterType() FilterType {
+	return FilterTypePercentPrice
+}
+
+// FilterType implement Filter
+func (f *LotSizeFilter) FilterType() FilterType {
+	return FilterTypeLotSize
+}
+
+// FilterType implement Filter
+func (f *MarketLotSizeFilter) FilterType() FilterType {
+	return FilterTypeMarketLotSize
+}
+
+// FilterType implement Filter
+func (f *MinNotionalFilter) FilterType() FilterType {
+	return FilterTypeMinNotional
+}
+
+// FilterType implement Filter
+func (f *IcebergPartsFilter) FilterType() FilterType {
+	return FilterTypeIcebergParts
+}
+
+// FilterType implement Filter
+func (f *MaxNumOrdersFilter) FilterType() FilterType {
+	return FilterTypeMaxNumOrders
+}
+
+// FilterType implement Filter
+func (f *MaxNumAlgoOrdersFilter) FilterType() FilterType {
+	return FilterTypeMaxNumAlgoOrders
+}
+
+// FilterType implement Filter
+func (f *MaxNumIcebergOrdersFilter) FilterType() FilterType {
+	return FilterTypeMaxNumIcebergOrders
+}
+
+// FilterType implement Filter
+func (f *ExchangeMaxNumOrdersFilter) FilterType() FilterType {
+	return FilterTypeExchangeMaxNumOrders
+}
+
+// FilterType implement Filter
+func (f *ExchangeMaxNumAlgoOrdersFilter) FilterType() FilterType {
+	return FilterTypeExchangeMaxNumAlgoOrders
+}
+
+// FilterType implement Filter
+func (f *UnknownFilter) FilterType() FilterType {
+	return f.Type
+}
+
+// decodeFilter decode a filter into its concrete type according to filterType
+func decodeFilter(data []byte) (Filter, error) {
+	head := struct {
+		FilterType FilterType `json:"filterType"`
+		// older responses use limit for the max num filters
+		Limit int `json:"limit"`
+	}{}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+	var f Filter
+	switch head.FilterType {
+	case FilterTypePrice:
+		f = new(PriceFilter)
+	case FilterTypePercentPrice:
+		f = new(PercentPriceFilter)
+	case FilterTypeLotSize:
+		f = new(LotSizeFilter)
+	case FilterTypeMarketLotSize:
+		f = new(MarketLotSizeFilter)
+	case FilterTypeMinNotional:
+		f = new(MinNotionalFilter)
+	case FilterTypeIcebergParts:
+		f = new(IcebergPartsFilter)
+	case FilterTypeMaxNumOrders:
+		f = &MaxNumOrdersFilter{MaxNumOrders: head.Limit}
+	case FilterTypeMaxNumAlgoOrders:
+		f = &MaxNumAlgoOrdersFilter{MaxNumAlgoOrders: head.Limit}
+	case FilterTypeMaxNumIcebergOrders:
+		f = &MaxNumIcebergOrdersFilter{MaxNumIcebergOrders: head.Limit}
+	case FilterTypeExchangeMaxNumOrders:
+		f = &ExchangeMaxNumOrdersFilter{MaxNumOrders: head.Limit}
+	case FilterTypeExchangeMaxNumAlgoOrders:
+		f = &ExchangeMaxNumAlgoOrdersFilter{MaxNumAlgoOrders: head.Limit}
+	default:
+		return &UnknownFilter{Type: head.FilterType, Raw: append(json.RawMessage(nil), data...)}, nil
+	}
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalJSON decode the flat fields of a filter and its typed form
+func (e *ExchangeInfoFilter) UnmarshalJSON(data []byte) error {
+	type filter ExchangeInfoFilter
+	if err := json.Unmarshal(data, (*filter)(e)); err != nil {
+		return err
+	}
+	typed, err := decodeFilter(data)
+	if err != nil {
+		return err
+	}
+	e.typed = typed
+	return nil
+}
+
+// Filter return the typed form of the filter. Filters built by hand are
+// converted from their flat fields, nil is returned for other types.
+func (e *ExchangeInfoFilter) Filter() Filter {
+	if e.typed != nil {
+		return e.typed
+	}
+	switch FilterType(e.FilterType) {
+	case FilterTypePrice:
+		return &PriceFilter{
+			MinPrice: e.MinPriceDecimal(),
+			MaxPrice: e.MaxPriceDecimal(),
+			TickSize: e.TickSizeDecimal(),
+		}
+	case FilterTypeLotSize:
+		return &LotSizeFilter{
+			MinQuantity: e.MinQtyDecimal(),
+			MaxQuantity: e.MaxQtyDecimal(),
+			StepSize:    e.StepSizeDecimal(),
+		}
+	case FilterTypeMarketLotSize:
+		return &MarketLotSizeFilter{
+			MinQuantity: e.MinQtyDecimal(),
+			MaxQuantity: e.MaxQtyDecimal(),
+			StepSize:    e.StepSizeDecimal(),
+		}
+	case FilterTypeMinNotional:
+		return &MinNotionalFilter{MinNotional: e.MinNotionalDecimal()}
+	}
+	return nil
+}
+
+// findFilter return the first typed filter of the given type
+func findFilter(filters []*ExchangeInfoFilter, filterType FilterType) Filter {
+	for _, f := range filters {
+		if f == nil {
+			continue
+		}
+		if typed := f.Filter(); typed != nil && typed.FilterType() == filterType {
+			return typed
+		}
+	}
+	return nil
+}
+
+// TypedFilters return the typed filters of the symbol
+func (s *ExchangeInfoSymbol) TypedFilters() []Filter {
+	filters := make([]Filter, 0, len(s.Filters))
+	for _, f := range s.Filters {
+		if f == nil {
+			continue
+		}
+		if typed := f.Filter(); typed != nil {
+			filters = append(filters, typed)
+		}
+	}
+	return filters
+}
+
+// PriceFilter return the PRICE_FILTER of the symbol, nil if absent
+func (s *ExchangeInfoSymbol) PriceFilter() *PriceFilter {
+	f, _ := findFilter(s.Filters, FilterTypePrice).(*PriceFilter)
+	return f
+}
+
+// PercentPriceFilter return the PERCENT_PRICE filter of the symbol, nil if absent
+func (s *ExchangeInfoSymbol) PercentPriceFilter() *PercentPriceFilter {
+	f, _ := findFilter(s.Filters, FilterTypePercentPrice).(*PercentPriceFilter)
+	return f
+}
+
+// LotSizeFilter return the LOT_SIZE filter of the symbol, nil if absent
+func (s *ExchangeInfoSymbol) LotSizeFilter() *LotSizeFilter {
+	f, _ := findFilter(s.Filters, FilterTypeLotSize).(*LotSizeFilter)
+	return f
+}
+
+// MarketLotSizeFilter return the MARKET_LOT_SIZE filter of the symbol, nil if absent
+func (s *ExchangeInfoSymbol) MarketLotSizeFilter() *MarketLotSizeFilter {
+	f, _ := findFilter(s.Filters, FilterTypeMarketLotSize).(*MarketLotSizeFilter)
+	return f
+}
+
+// MinNotionalFilter return the MIN_NOTIONAL filter of the symbol, nil if absent
+func (s *ExchangeInfoSymbol) MinNotionalFilter() *MinNotionalFilter {
+	f, _ := findFilter(s.Filters, FilterTypeMinNotional).(*MinNotionalFilter)
+	return f
+}
+
+// IcebergPartsFilter return the ICEBERG_PARTS filter of the symbol, nil if absent
+func (s *ExchangeInfoSymbol) IcebergPartsFilter() *IcebergPartsFilter {
+	f, _ := findFilter(s.Filters, FilterTypeIcebergParts).(*IcebergPartsFilter)
+	return f
+}
+
+// MaxNumOrdersFilter return the MAX_NUM_ORDERS filter of the symbol, nil if absent
+func (s *ExchangeInfoSymbol) MaxNumOrdersFilter() *MaxNumOrdersFilter {
+	f, _ := findFilter(s.Filters, FilterTypeMaxNumOrders).(*MaxNumOrdersFilter)
+	return f
+}
+
+// MaxNumAlgoOrdersFilter return the MAX_NUM_ALGO_ORDERS filter of the symbol, nil if absent
+func (s *ExchangeInfoSymbol) MaxNumAlgoOrdersFilter() *MaxNumAlgoOrdersFilter {
+	f, _ := findFilter(s.Filters, FilterTypeMaxNumAlgoOrders).(*MaxNumAlgoOrdersFilter)
+	return f
+}
+
+// MaxNumIcebergOrdersFilter return the MAX_NUM_ICEBERG_ORDERS filter of the symbol, nil if absent
+func (s *ExchangeInfoSymbol) MaxNumIcebergOrdersFilter() *MaxNumIcebergOrdersFilter {
+	f, _ := findFilter(s.Filters, FilterTypeMaxNumIcebergOrders).(*MaxNumIcebergOrdersFilter)
+	return f
+}
+
+// TypedExchangeFilters return the typed exchange level filters
+func (r *ExchangeInfoResponse) TypedExchangeFilters() []Filter {
+	filters := make([]Filter, 0, len(r.ExchangeFilters))
+	for _, f := range r.ExchangeFilters {
+		if f == nil {
+			continue
+		}
+		if typed := f.Filter(); typed != nil {
+			filters = append(filters, typed)
+		}
+	}
+	return filters
+}
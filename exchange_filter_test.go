@@ -0,0 +1,107 @@
+package binance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type exchangeFilterTestSuite struct {
+	baseTestSuite
+}
+
+func TestExchangeFilter(t *testing.T) {
+	suite.Run(t, new(exchangeFilterTestSuite))
+}
+
+const exchangeInfoFiltersJSON = `{
+	"timezone": "UTC",
+	"serverTime": 1508631584636,
+	"exchangeFilters": [
+		{"filterType": "EXCHANGE_MAX_NUM_ORDERS", "maxNumOrders": 1000},
+		{"filterType": "EXCHANGE_MAX_NUM_ALGO_ORDERS", "limit": 200}
+	],
+	"symbols": [{
+		"symbol": "ETHBTC",
+		"status": "TRADING",
+		"baseAsset": "ETH",
+		"baseAssetPrecision": 8,
+		"quoteAsset": "BTC",
+		"quotePrecision": 8,
+		"orderTypes": ["LIMIT", "MARKET"],
+		"icebergAllowed": true,
+		"filters": [
+			{"filterType": "PRICE_FILTER", "minPrice": "0.00000100", "maxPrice": "100000.00000000", "tickSize": "0.00000100"},
+			{"filterType": "PERCENT_PRICE", "multiplierUp": "5", "multiplierDown": "0.2", "avgPriceMins": 5},
+			{"filterType": "LOT_SIZE", "minQty": "0.00100000", "maxQty": "100000.00000000", "stepSize": "0.00100000"},
+			{"filterType": "MIN_NOTIONAL", "minNotional": "0.00100000", "applyToMarket": true, "avgPriceMins": 5},
+			{"filterType": "ICEBERG_PARTS", "limit": 10},
+			{"filterType": "MARKET_LOT_SIZE", "minQty": "0.00000000", "maxQty": "3000.00000000", "stepSize": "0.00000000"},
+			{"filterType": "MAX_NUM_ORDERS", "maxNumOrders": 200},
+			{"filterType": "MAX_NUM_ALGO_ORDERS", "maxNumAlgoOrders": 5},
+			{"filterType": "MAX_NUM_ICEBERG_ORDERS", "maxNumIcebergOrders": 100},
+			{"filterType": "TRAILING_DELTA", "minTrailingAboveDelta": 10}
+		]
+	}]
+}`
+
+func (s *exchangeFilterTestSuite) TestDecodeFilters() {
+	r := s.r()
+	res := new(ExchangeInfoResponse)
+	r.NoError(json.Unmarshal([]byte(exchangeInfoFiltersJSON), res))
+	r.Len(res.Symbols, 1)
+	symbol := res.Symbols[0]
+
+	r.Equal(&PriceFilter{
+		MinPrice: MustParseDecimal("0.00000100"),
+		MaxPrice: MustParseDecimal("100000.00000000"),
+		TickSize: MustParseDecimal("0.00000100"),
+	}, symbol.PriceFilter())
+	r.Equal(&PercentPriceFilter{
+		MultiplierUp:   MustParseDecimal("5"),
+		MultiplierDown: MustParseDecimal("0.2"),
+		AvgPriceMins:   5,
+	}, symbol.PercentPriceFilter())
+	r.Equal("0.00100000", symbol.LotSizeFilter().StepSize.String())
+	r.Equal("3000.00000000", symbol.MarketLotSizeFilter().MaxQuantity.String())
+	r.Equal(&MinNotionalFilter{
+		MinNotional:   MustParseDecimal("0.00100000"),
+		ApplyToMarket: true,
+		AvgPriceMins:  5,
+	}, symbol.MinNotionalFilter())
+	r.Equal(&IcebergPartsFilter{Limit: 10}, symbol.IcebergPartsFilter())
+	r.Equal(&MaxNumOrdersFilter{MaxNumOrders: 200}, symbol.MaxNumOrdersFilter())
+	r.Equal(&MaxNumAlgoOrdersFilter{MaxNumAlgoOrders: 5}, symbol.MaxNumAlgoOrdersFilter())
+	r.Equal(&MaxNumIcebergOrdersFilter{MaxNumIcebergOrders: 100}, symbol.MaxNumIcebergOrdersFilter())
+
+	filters := symbol.TypedFilters()
+	r.Len(filters, 10)
+	unknown, ok := filters[9].(*UnknownFilter)
+	r.True(ok)
+	r.Equal(FilterType("TRAILING_DELTA"), unknown.FilterType())
+	r.Contains(string(unknown.Raw), "minTrailingAboveDelta")
+
+	// flat fields are still decoded
+	r.Equal("0.00000100", symbol.Filters[0].TickSize)
+
+	r.Equal([]Filter{
+		&ExchangeMaxNumOrdersFilter{MaxNumOrders: 1000},
+		&ExchangeMaxNumAlgoOrdersFilter{MaxNumAlgoOrders: 200},
+	}, res.TypedExchangeFilters())
+}
+
+func (s *exchangeFilterTestSuite) TestFilterFromFlatFields() {
+	r := s.r()
+	symbol := &ExchangeInfoSymbol{
+		Filters: []*ExchangeInfoFilter{
+			{FilterType: "LOT_SIZE", MinQty: "0.1", MaxQty: "10", StepSize: "0.1"},
+		},
+	}
+	r.Equal(&LotSizeFilter{
+		MinQuantity: MustParseDecimal("0.1"),
+		MaxQuantity: MustParseDecimal("10"),
+		StepSize:    MustParseDecimal("0.1"),
+	}, symbol.LotSizeFilter())
+	r.Nil(symbol.PriceFilter())
+}
@@ -3,6 +3,7 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"time"
 )
 
 // ExchangeInfoService show exchange info
@@ -27,32 +28,42 @@ func (s *ExchangeInfoService) Do(ctx context.Context, opts ...RequestOption) (*E
 	if err = json.Unmarshal(data, res); err != nil {
 		return nil, err
 	}
+	if s.c.RateLimiter != nil && len(res.RateLimits) > 0 {
+		s.c.RateLimiter.SetLimits(res.RateLimits)
+	}
 
 	return res, nil
 }
 
 // DepthResponse define depth info with bids and asks
 type ExchangeInfoResponse struct {
-	Timezone   string                   `json:"timezone"`
-	ServerTime int64                    `json:"serverTime"`
-	RateLimits []*ExchangeInfoRateLimit `json:"rateLimits"`
-	Symbols    []*ExchangeInfoSymbol    `json:"symbols"`
+	Timezone        string                   `json:"timezone"`
+	ServerTime      int64                    `json:"serverTime"`
+	RateLimits      []*ExchangeInfoRateLimit `json:"rateLimits"`
+	ExchangeFilters []*ExchangeInfoFilter    `json:"exchangeFilters"`
+	Symbols         []*ExchangeInfoSymbol    `json:"symbols"`
+}
+
+// ServerTimeUTC return server time as a UTC time
+func (r *ExchangeInfoResponse) ServerTimeUTC() time.Time {
+	return MillisToTime(r.ServerTime)
 }
 
 type ExchangeInfoRateLimit struct {
 	RateLimitType string `json:"rateLimitType"`
 	Interval      string `json:"interval"`
+	IntervalNum   int    `json:"intervalNum"`
 	Limit         int    `json:"limit"`
 }
 
 type ExchangeInfoSymbol struct {
 	Symbol             string                `json:"symbol"`
-	Status             string                `json:"status"`
+	Status             SymbolStatus          `json:"status"`
 	BaseAsset          string                `json:"baseAsset"`
 	BaseAssetPrecision int                   `json:"baseAssetPrecision"`
 	QuoteAsset         string                `json:"quoteAsset"`
 	QuotePrecision     int                   `json:"quotePrecision"`
-	OrderTypes         []string              `json:"orderTypes"`
+	OrderTypes         []OrderType           `json:"orderTypes"`
 	IcebergAllowed     bool                  `json:"icebergAllowed"`
 	Filters            []*ExchangeInfoFilter `json:"filters"`
 }
@@ -66,4 +77,40 @@ type ExchangeInfoFilter struct {
 	MaxQty      string `json:"maxQty"`
 	StepSize    string `json:"stepSize"`
 	MinNotional string `json:"minNotional"`
+	typed       Filter
+}
+
+// MinPriceDecimal return min price as a decimal, zero if it is empty or malformed
+func (e *ExchangeInfoFilter) MinPriceDecimal() Decimal {
+	return parseDecimalOrZero(e.MinPrice)
+}
+
+// MaxPriceDecimal return max price as a decimal, zero if it is empty or malformed
+func (e *ExchangeInfoFilter) MaxPriceDecimal() Decimal {
+	return parseDecimalOrZero(e.MaxPrice)
+}
+
+// TickSizeDecimal return tick size as a decimal, zero if it is empty or malformed
+func (e *ExchangeInfoFilter) TickSizeDecimal() Decimal {
+	return parseDecimalOrZero(e.TickSize)
+}
+
+// MinQtyDecimal return min qty as a decimal, zero if it is empty or malformed
+func (e *ExchangeInfoFilter) MinQtyDecimal() Decimal {
+	return parseDecimalOrZero(e.MinQty)
+}
+
+// MaxQtyDecimal return max qty as a decimal, zero if it is empty or malformed
+func (e *ExchangeInfoFilter) MaxQtyDecimal() Decimal {
+	return parseDecimalOrZero(e.MaxQty)
+}
+
+// StepSizeDecimal return step size as a decimal, zero if it is empty or malformed
+func (e *ExchangeInfoFilter) StepSizeDecimal() Decimal {
+	return parseDecimalOrZero(e.StepSize)
+}
+
+// MinNotionalDecimal return min notional as a decimal, zero if it is empty or malformed
+func (e *ExchangeInfoFilter) MinNotionalDecimal() Decimal {
+	return parseDecimalOrZero(e.MinNotional)
 }
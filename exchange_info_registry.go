@@ -0,0 +1,231 @@
+package binance
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// ExchangeInfoEventType define type of exchange info change
+type ExchangeInfoEventType string
+
+// Exchange info change types
+const (
+	ExchangeInfoEventSymbolAdded          ExchangeInfoEventType = "SYMBOL_ADDED"
+	ExchangeInfoEventSymbolRemoved        ExchangeInfoEventType = "SYMBOL_REMOVED"
+	ExchangeInfoEventSymbolStatusChanged  ExchangeInfoEventType = "SYMBOL_STATUS_CHANGED"
+	ExchangeInfoEventSymbolFiltersChanged ExchangeInfoEventType = "SYMBOL_FILTERS_CHANGED"
+)
+
+// ExchangeInfoEvent define a symbol change found by a refresh of the
+// registry. Old is nil for added symbols and New is nil for removed ones.
+type ExchangeInfoEvent struct {
+	Type   ExchangeInfoEventType
+	Symbol string
+	Old    *ExchangeInfoSymbol
+	New    *ExchangeInfoSymbol
+}
+
+// ExchangeInfoHandler handle exchange info change events
+type ExchangeInfoHandler func(event *ExchangeInfoEvent)
+
+// ExchangeInfoRegistry cache the exchange info and index its symbols.
+// Get it with Client.ExchangeInfo.
+type ExchangeInfoRegistry struct {
+	c *Client
+
+	refreshMu  sync.Mutex
+	mu         sync.RWMutex
+	info       *ExchangeInfoResponse
+	symbols    map[string]*ExchangeInfoSymbol
+	byBase     map[string][]*ExchangeInfoSymbol
+	byQuote    map[string][]*ExchangeInfoSymbol
+	byAssets   map[[2]string]*ExchangeInfoSymbol
+	updateTime time.Time
+	handlers   map[int]ExchangeInfoHandler
+	nextID     int
+}
+
+// ExchangeInfo return the exchange info registry of the client
+func (c *Client) ExchangeInfo() *ExchangeInfoRegistry {
+	c.exchangeInfoOnce.Do(func() {
+		c.exchangeInfo = &ExchangeInfoRegistry{
+			c:        c,
+			handlers: make(map[int]ExchangeInfoHandler),
+		}
+	})
+	return c.exchangeInfo
+}
+
+// Load refresh the registry if it was never loaded
+func (r *ExchangeInfoRegistry) Load(ctx context.Context) error {
+	r.mu.RLock()
+	loaded := r.info != nil
+	r.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+	return r.Refresh(ctx)
+}
+
+// Refresh fetch the exchange info, replace the cache and emit the changes
+// since the previous refresh. No event is emitted by the first refresh.
+func (r *ExchangeInfoRegistry) Refresh(ctx context.Context) error {
+	r.refreshMu.Lock()
+	defer r.refreshMu.Unlock()
+	info, err := r.c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	r.update(info, time.Now())
+	return nil
+}
+
+// update replace the cache with info and notify the handlers
+func (r *ExchangeInfoRegistry) update(info *ExchangeInfoResponse, now time.Time) {
+	symbols := make(map[string]*ExchangeInfoSymbol, len(info.Symbols))
+	byBase := make(map[string][]*ExchangeInfoSymbol)
+	byQuote := make(map[string][]*ExchangeInfoSymbol)
+	byAssets := make(map[[2]string]*ExchangeInfoSymbol, len(info.Symbols))
+	for _, s := range info.Symbols {
+		symbols[s.Symbol] = s
+		byBase[s.BaseAsset] = append(byBase[s.BaseAsset], s)
+		byQuote[s.QuoteAsset] = append(byQuote[s.QuoteAsset], s)
+		byAssets[[2]string{s.BaseAsset, s.QuoteAsset}] = s
+	}
+
+	r.mu.Lock()
+	var events []*ExchangeInfoEvent
+	if r.info != nil {
+		events = diffExchangeInfo(r.info.Symbols, r.symbols, info.Symbols, symbols)
+	}
+	r.info, r.symbols, r.updateTime = info, symbols, now
+	r.byBase, r.byQuote, r.byAssets = byBase, byQuote, byAssets
+	handlers := make([]ExchangeInfoHandler, 0, len(r.handlers))
+	for id := 0; id < r.nextID; id++ {
+		if h, ok := r.handlers[id]; ok {
+			handlers = append(handlers, h)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, event := range events {
+		for _, h := range handlers {
+			h(event)
+		}
+	}
+}
+
+// diffExchangeInfo list the changes between two sets of symbols in the
+// order of the responses
+func diffExchangeInfo(oldList []*ExchangeInfoSymbol, oldMap map[string]*ExchangeInfoSymbol,
+	newList []*ExchangeInfoSymbol, newMap map[string]*ExchangeInfoSymbol) []*ExchangeInfoEvent {
+	var events []*ExchangeInfoEvent
+	for _, s := range newList {
+		old, ok := oldMap[s.Symbol]
+		if !ok {
+			events = append(events, &ExchangeInfoEvent{Type: ExchangeInfoEventSymbolAdded, Symbol: s.Symbol, New: s})
+			continue
+		}
+		if old.Status != s.Status {
+			events = append(events, &ExchangeInfoEvent{Type: ExchangeInfoEventSymbolStatusChanged, Symbol: s.Symbol, Old: old, New: s})
+		}
+		if !reflect.DeepEqual(old.TypedFilters(), s.TypedFilters()) {
+			events = append(events, &ExchangeInfoEvent{Type: ExchangeInfoEventSymbolFiltersChanged, Symbol: s.Symbol, Old: old, New: s})
+		}
+	}
+	for _, s := range oldList {
+		if _, ok := newMap[s.Symbol]; !ok {
+			events = append(events, &ExchangeInfoEvent{Type: ExchangeInfoEventSymbolRemoved, Symbol: s.Symbol, Old: s})
+		}
+	}
+	return events
+}
+
+// Start refresh the registry every interval until ctx is done. Refresh
+// errors are passed to errHandler if it is not nil.
+func (r *ExchangeInfoRegistry) Start(ctx context.Context, interval time.Duration, errHandler func(err error)) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Refresh(ctx); err != nil && errHandler != nil && ctx.Err() == nil {
+					errHandler(err)
+				}
+			}
+		}
+	}()
+}
+
+// Subscribe register handler for change events and return a function
+// removing it
+func (r *ExchangeInfoRegistry) Subscribe(handler ExchangeInfoHandler) (unsubscribe func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := r.nextID
+	r.nextID++
+	r.handlers[id] = handler
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.handlers, id)
+	}
+}
+
+// Response return the cached exchange info, nil if not loaded
+func (r *ExchangeInfoRegistry) Response() *ExchangeInfoResponse {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.info
+}
+
+// UpdateTime return the time of the last refresh
+func (r *ExchangeInfoRegistry) UpdateTime() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.updateTime
+}
+
+// Symbol return the cached info of symbol
+func (r *ExchangeInfoRegistry) Symbol(symbol string) (*ExchangeInfoSymbol, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.symbols[symbol]
+	return s, ok
+}
+
+// SymbolRules return the rules of symbol built from the cached info
+func (r *ExchangeInfoRegistry) SymbolRules(symbol string) (*SymbolRules, bool) {
+	s, ok := r.Symbol(symbol)
+	if !ok {
+		return nil, false
+	}
+	return NewSymbolRules(s), true
+}
+
+// SymbolsByBaseAsset return the symbols trading asset as base asset
+func (r *ExchangeInfoRegistry) SymbolsByBaseAsset(asset string) []*ExchangeInfoSymbol {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]*ExchangeInfoSymbol(nil), r.byBase[asset]...)
+}
+
+// SymbolsByQuoteAsset return the symbols quoted in asset
+func (r *ExchangeInfoRegistry) SymbolsByQuoteAsset(asset string) []*ExchangeInfoSymbol {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]*ExchangeInfoSymbol(nil), r.byQuote[asset]...)
+}
+
+// SymbolByAssets return the symbol trading base against quote
+func (r *ExchangeInfoRegistry) SymbolByAssets(base, quote string) (*ExchangeInfoSymbol, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.byAssets[[2]string{base, quote}]
+	return s, ok
+}
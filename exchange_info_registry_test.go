@@ -0,0 +1,113 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type exchangeInfoRegistryTestSuite struct {
+	baseTestSuite
+}
+
+func TestExchangeInfoRegistry(t *testing.T) {
+	suite.Run(t, new(exchangeInfoRegistryTestSuite))
+}
+
+func (s *exchangeInfoRegistryTestSuite) TestRefresh() {
+	s.mockDoOnce([]byte(`{"symbols":[
+		{"symbol":"ETHBTC","status":"TRADING","baseAsset":"ETH","quoteAsset":"BTC",
+			"filters":[{"filterType":"PRICE_FILTER","minPrice":"0.000001","maxPrice":"100","tickSize":"0.000001"}]},
+		{"symbol":"BNBBTC","status":"TRADING","baseAsset":"BNB","quoteAsset":"BTC"},
+		{"symbol":"LTCBTC","status":"TRADING","baseAsset":"LTC","quoteAsset":"BTC"}
+	]}`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`{"symbols":[
+		{"symbol":"ETHBTC","status":"TRADING","baseAsset":"ETH","quoteAsset":"BTC",
+			"filters":[{"filterType":"PRICE_FILTER","minPrice":"0.000001","maxPrice":"100","tickSize":"0.00001"}]},
+		{"symbol":"BNBBTC","status":"HALT","baseAsset":"BNB","quoteAsset":"BTC"},
+		{"symbol":"BNBETH","status":"TRADING","baseAsset":"BNB","quoteAsset":"ETH"}
+	]}`), nil, http.StatusOK)
+
+	registry := s.client.ExchangeInfo()
+	r := s.r()
+	r.Same(registry, s.client.ExchangeInfo())
+	r.Nil(registry.Response())
+	_, ok := registry.Symbol("ETHBTC")
+	r.False(ok)
+
+	var events []*ExchangeInfoEvent
+	unsubscribe := registry.Subscribe(func(event *ExchangeInfoEvent) {
+		events = append(events, event)
+	})
+
+	r.NoError(registry.Load(newContext()))
+	r.Empty(events)
+	r.WithinDuration(time.Now(), registry.UpdateTime(), time.Second)
+	symbol, ok := registry.Symbol("ETHBTC")
+	r.True(ok)
+	r.Equal("ETH", symbol.BaseAsset)
+	r.Len(registry.SymbolsByQuoteAsset("BTC"), 3)
+	symbol, ok = registry.SymbolByAssets("LTC", "BTC")
+	r.True(ok)
+	r.Equal("LTCBTC", symbol.Symbol)
+	rules, ok := registry.SymbolRules("ETHBTC")
+	r.True(ok)
+	r.Equal("0.000001", rules.TickSize.String())
+
+	// loaded registry is not refreshed by Load
+	r.NoError(registry.Load(newContext()))
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+
+	r.NoError(registry.Refresh(newContext()))
+	r.Len(events, 4)
+	r.Equal(ExchangeInfoEventSymbolFiltersChanged, events[0].Type)
+	r.Equal("ETHBTC", events[0].Symbol)
+	r.Equal("0.000001", events[0].Old.PriceFilter().TickSize.String())
+	r.Equal("0.00001", events[0].New.PriceFilter().TickSize.String())
+	r.Equal(ExchangeInfoEventSymbolStatusChanged, events[1].Type)
+	r.Equal(SymbolStatusTrading, events[1].Old.Status)
+	r.Equal(SymbolStatusHalt, events[1].New.Status)
+	r.Equal(ExchangeInfoEventSymbolAdded, events[2].Type)
+	r.Equal("BNBETH", events[2].Symbol)
+	r.Nil(events[2].Old)
+	r.Equal(ExchangeInfoEventSymbolRemoved, events[3].Type)
+	r.Equal("LTCBTC", events[3].Symbol)
+	r.Nil(events[3].New)
+
+	r.Len(registry.SymbolsByBaseAsset("BNB"), 2)
+	_, ok = registry.SymbolByAssets("LTC", "BTC")
+	r.False(ok)
+
+	unsubscribe()
+	registry.update(&ExchangeInfoResponse{}, time.Now())
+	r.Len(events, 4)
+}
+
+func (s *exchangeInfoRegistryTestSuite) TestStart() {
+	s.mockDoOnce([]byte(`{"code":-1000,"msg":"unknown"}`), nil, http.StatusBadRequest)
+	s.mockDo([]byte(`{"symbols":[{"symbol":"ETHBTC","status":"TRADING","baseAsset":"ETH","quoteAsset":"BTC"}]}`), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errs := make(chan error, 1)
+	registry := s.client.ExchangeInfo()
+	registry.Start(ctx, 10*time.Millisecond, func(err error) {
+		errs <- err
+	})
+
+	r := s.r()
+	select {
+	case err := <-errs:
+		r.True(errors.Is(err, &APIError{Code: ErrCodeUnknown}))
+	case <-time.After(time.Second):
+		r.Fail("no refresh error")
+	}
+	r.Eventually(func() bool {
+		_, ok := registry.Symbol("ETHBTC")
+		return ok
+	}, time.Second, 5*time.Millisecond)
+}
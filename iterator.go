@@ -0,0 +1,359 @@
+package binance
+
+import (
+	"context"
+)
+
+// maxPageLimit is the largest page size of the paginated endpoints
+const maxPageLimit = 1000
+
+// pager fetch pages on demand and track the position in the current page.
+// fetch return the size of the new page and whether it is the last one.
+type pager struct {
+	ctx   context.Context
+	fetch func(ctx context.Context) (n int, last bool, err error)
+	pos   int
+	n     int
+	last  bool
+	err   error
+}
+
+func newPager(ctx context.Context, fetch func(ctx context.Context) (int, bool, error)) *pager {
+	return &pager{ctx: ctx, fetch: fetch, pos: -1}
+}
+
+// next advance to the next item, fetching pages until one is not empty
+func (p *pager) next() bool {
+	if p.err != nil {
+		return false
+	}
+	for p.pos+1 >= p.n {
+		if p.last {
+			return false
+		}
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+		n, last, err := p.fetch(p.ctx)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.pos, p.n, p.last = -1, n, last
+	}
+	p.pos++
+	return true
+}
+
+// pageEnd check if the current item is the last one of the fetched page
+func (p *pager) pageEnd() bool {
+	return p.pos >= 0 && p.pos+1 == p.n
+}
+
+// pageLimit return the page size to request
+func pageLimit(limit *int) int {
+	if limit == nil || *limit <= 0 || *limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return *limit
+}
+
+// KlineIterator iterate over the klines of a time range page by page
+type KlineIterator struct {
+	*pager
+	page []*Kline
+}
+
+// Value return the current kline
+func (it *KlineIterator) Value() *Kline {
+	return it.page[it.pos]
+}
+
+// Next advance to the next kline, it return false at the end of the range
+// or on error
+func (it *KlineIterator) Next() bool {
+	return it.next()
+}
+
+// Err return the error which stopped the iteration
+func (it *KlineIterator) Err() error {
+	return it.err
+}
+
+// PageEnd report whether the current value is the last one of its page,
+// a point where no fetched value is pending
+func (it *KlineIterator) PageEnd() bool {
+	return it.pageEnd()
+}
+
+// Iterate return an iterator over all the klines from startTime to endTime,
+// or until now without endTime. The limit is used as page size.
+func (s *KlinesService) Iterate(ctx context.Context, opts ...RequestOption) *KlineIterator {
+	svc := *s
+	limit := pageLimit(s.limit)
+	svc.Limit(limit)
+	if svc.startTime == nil {
+		svc.StartTime(0)
+	}
+	it := new(KlineIterator)
+	lastOpenTime := int64(-1)
+	it.pager = newPager(ctx, func(ctx context.Context) (int, bool, error) {
+		klines, err := svc.Do(ctx, opts...)
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = it.page[:0]
+		for _, k := range klines {
+			if k.OpenTime > lastOpenTime {
+				it.page = append(it.page, k)
+				lastOpenTime = k.OpenTime
+			}
+		}
+		svc.StartTime(lastOpenTime + 1)
+		return len(it.page), len(klines) < limit || len(it.page) == 0, nil
+	})
+	return it
+}
+
+// AggTradeIterator iterate over aggregate trades page by page
+type AggTradeIterator struct {
+	*pager
+	page []*AggTrade
+}
+
+// Value return the current aggregate trade
+func (it *AggTradeIterator) Value() *AggTrade {
+	return it.page[it.pos]
+}
+
+// Next advance to the next aggregate trade, it return false at the end of
+// the range or on error
+func (it *AggTradeIterator) Next() bool {
+	return it.next()
+}
+
+// Err return the error which stopped the iteration
+func (it *AggTradeIterator) Err() error {
+	return it.err
+}
+
+// PageEnd report whether the current value is the last one of its page,
+// a point where no fetched value is pending
+func (it *AggTradeIterator) PageEnd() bool {
+	return it.pageEnd()
+}
+
+// Iterate return an iterator over all the aggregate trades from fromID, or
+// from startTime, until endTime or the latest trade. The first page is
+// located with startTime, the following ones are requested by ID.
+func (s *AggTradesService) Iterate(ctx context.Context, opts ...RequestOption) *AggTradeIterator {
+	svc := *s
+	limit := pageLimit(s.limit)
+	svc.Limit(limit)
+	endTime := s.endTime
+	if svc.fromID == nil && svc.startTime != nil {
+		// the API only accepts a window of one hour with both bounds
+		svc.endTime = nil
+	} else {
+		svc.startTime, svc.endTime = nil, nil
+	}
+	if svc.fromID == nil && svc.startTime == nil {
+		svc.FromID(0)
+	}
+	it := new(AggTradeIterator)
+	lastID := int64(-1)
+	it.pager = newPager(ctx, func(ctx context.Context) (int, bool, error) {
+		trades, err := svc.Do(ctx, opts...)
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = it.page[:0]
+		last := len(trades) < limit
+		for _, t := range trades {
+			if endTime != nil && t.Timestamp > *endTime {
+				last = true
+				break
+			}
+			if t.AggTradeID > lastID {
+				it.page = append(it.page, t)
+				lastID = t.AggTradeID
+			}
+		}
+		svc.startTime = nil
+		svc.FromID(lastID + 1)
+		return len(it.page), last || len(it.page) == 0, nil
+	})
+	return it
+}
+
+// TradeIterator iterate over the trades of the account page by page
+type TradeIterator struct {
+	*pager
+	page []*Trade
+}
+
+// Value return the current trade
+func (it *TradeIterator) Value() *Trade {
+	return it.page[it.pos]
+}
+
+// Next advance to the next trade, it return false after the latest trade
+// or on error
+func (it *TradeIterator) Next() bool {
+	return it.next()
+}
+
+// Err return the error which stopped the iteration
+func (it *TradeIterator) Err() error {
+	return it.err
+}
+
+// PageEnd report whether the current value is the last one of its page,
+// a point where no fetched value is pending
+func (it *TradeIterator) PageEnd() bool {
+	return it.pageEnd()
+}
+
+// Iterate return an iterator over all the trades of the account from
+// fromID, or from the first trade, until the latest trade
+func (s *ListTradesService) Iterate(ctx context.Context, opts ...RequestOption) *TradeIterator {
+	svc := *s
+	limit := pageLimit(s.limit)
+	svc.Limit(limit)
+	if svc.fromID == nil {
+		svc.FromID(0)
+	}
+	it := new(TradeIterator)
+	lastID := int64(-1)
+	it.pager = newPager(ctx, func(ctx context.Context) (int, bool, error) {
+		trades, err := svc.Do(ctx, opts...)
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = it.page[:0]
+		for _, t := range trades {
+			if t.ID > lastID {
+				it.page = append(it.page, t)
+				lastID = t.ID
+			}
+		}
+		svc.FromID(lastID + 1)
+		return len(it.page), len(trades) < limit || len(it.page) == 0, nil
+	})
+	return it
+}
+
+// HistoricalTradeIterator iterate over the trades of a symbol page by page
+type HistoricalTradeIterator struct {
+	*pager
+	page []*HistoricalTrade
+}
+
+// Value return the current trade
+func (it *HistoricalTradeIterator) Value() *HistoricalTrade {
+	return it.page[it.pos]
+}
+
+// Next advance to the next trade, it return false after the latest trade
+// or on error
+func (it *HistoricalTradeIterator) Next() bool {
+	return it.next()
+}
+
+// Err return the error which stopped the iteration
+func (it *HistoricalTradeIterator) Err() error {
+	return it.err
+}
+
+// PageEnd report whether the current value is the last one of its page,
+// a point where no fetched value is pending
+func (it *HistoricalTradeIterator) PageEnd() bool {
+	return it.pageEnd()
+}
+
+// Iterate return an iterator over the trades of the symbol from fromID, or
+// from the first trade, until the latest trade
+func (s *HistoricalTradesService) Iterate(ctx context.Context, opts ...RequestOption) *HistoricalTradeIterator {
+	svc := *s
+	limit := pageLimit(s.limit)
+	svc.Limit(limit)
+	if svc.fromID == nil {
+		svc.FromID(0)
+	}
+	it := new(HistoricalTradeIterator)
+	lastID := int64(-1)
+	it.pager = newPager(ctx, func(ctx context.Context) (int, bool, error) {
+		trades, err := svc.Do(ctx, opts...)
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = it.page[:0]
+		for _, t := range trades {
+			if t.ID > lastID {
+				it.page = append(it.page, t)
+				lastID = t.ID
+			}
+		}
+		svc.FromID(lastID + 1)
+		return len(it.page), len(trades) < limit || len(it.page) == 0, nil
+	})
+	return it
+}
+
+// OrderIterator iterate over the orders of the account page by page
+type OrderIterator struct {
+	*pager
+	page []*Order
+}
+
+// Value return the current order
+func (it *OrderIterator) Value() *Order {
+	return it.page[it.pos]
+}
+
+// Next advance to the next order, it return false after the latest order
+// or on error
+func (it *OrderIterator) Next() bool {
+	return it.next()
+}
+
+// Err return the error which stopped the iteration
+func (it *OrderIterator) Err() error {
+	return it.err
+}
+
+// PageEnd report whether the current value is the last one of its page,
+// a point where no fetched value is pending
+func (it *OrderIterator) PageEnd() bool {
+	return it.pageEnd()
+}
+
+// Iterate return an iterator over all the orders of the symbol from
+// orderID, or from the first order, until the latest order
+func (s *ListOrdersService) Iterate(ctx context.Context, opts ...RequestOption) *OrderIterator {
+	svc := *s
+	limit := pageLimit(s.limit)
+	svc.Limit(limit)
+	if svc.orderID == nil {
+		svc.OrderID(0)
+	}
+	it := new(OrderIterator)
+	lastID := int64(-1)
+	it.pager = newPager(ctx, func(ctx context.Context) (int, bool, error) {
+		orders, err := svc.Do(ctx, opts...)
+		if err != nil {
+			return 0, false, err
+		}
+		it.page = it.page[:0]
+		for _, o := range orders {
+			if o.OrderID > lastID {
+				it.page = append(it.page, o)
+				lastID = o.OrderID
+			}
+		}
+		svc.OrderID(lastID + 1)
+		return len(it.page), len(orders) < limit || len(it.page) == 0, nil
+	})
+	return it
+}
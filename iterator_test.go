@@ -0,0 +1,147 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type iteratorTestSuite struct {
+	baseTestSuite
+	queries []map[string]string
+}
+
+func TestIterator(t *testing.T) {
+	suite.Run(t, new(iteratorTestSuite))
+}
+
+func (s *iteratorTestSuite) recordReqs() {
+	s.queries = nil
+	s.assertReq(func(r *request) {
+		q := map[string]string{}
+		for k := range r.query {
+			q[k] = r.query.Get(k)
+		}
+		s.queries = append(s.queries, q)
+	})
+}
+
+func (s *iteratorTestSuite) TestKlines() {
+	s.mockDoOnce([]byte(`[[1000,"1","1","1","1","1",1999,"1",1,"1","1","0"],
+		[2000,"1","1","1","1","1",2999,"1",1,"1","1","0"]]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`[[2000,"1","1","1","1","1",2999,"1",1,"1","1","0"],
+		[3000,"1","1","1","1","1",3999,"1",1,"1","1","0"]]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`[[4000,"1","1","1","1","1",4999,"1",1,"1","1","0"]]`), nil, http.StatusOK)
+	s.recordReqs()
+
+	it := s.client.NewKlinesService().Symbol("LTCBTC").Interval(KlineInterval1s).
+		StartTime(1000).EndTime(5000).Limit(2).Iterate(newContext())
+	var openTimes []int64
+	var pageEnds []bool
+	r := s.r()
+	r.False(it.PageEnd())
+	for it.Next() {
+		openTimes = append(openTimes, it.Value().OpenTime)
+		pageEnds = append(pageEnds, it.PageEnd())
+	}
+	r.NoError(it.Err())
+	r.Equal([]int64{1000, 2000, 3000, 4000}, openTimes)
+	r.Equal([]bool{false, true, true, true}, pageEnds)
+	r.Len(s.queries, 3)
+	r.Equal("1000", s.queries[0]["startTime"])
+	r.Equal("2001", s.queries[1]["startTime"])
+	r.Equal("3001", s.queries[2]["startTime"])
+	r.Equal("5000", s.queries[2]["endTime"])
+	r.Equal("2", s.queries[2]["limit"])
+}
+
+func (s *iteratorTestSuite) TestAggTrades() {
+	s.mockDoOnce([]byte(`[{"a":1,"T":100},{"a":2,"T":200}]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`[{"a":3,"T":300},{"a":4,"T":600}]`), nil, http.StatusOK)
+	s.recordReqs()
+
+	it := s.client.NewAggTradesService().Symbol("LTCBTC").
+		StartTime(100).EndTime(500).Limit(2).Iterate(newContext())
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Value().AggTradeID)
+	}
+	r := s.r()
+	r.NoError(it.Err())
+	r.Equal([]int64{1, 2, 3}, ids)
+	r.Len(s.queries, 2)
+	r.Equal("100", s.queries[0]["startTime"])
+	r.Empty(s.queries[0]["endTime"])
+	r.Empty(s.queries[1]["startTime"])
+	r.Equal("3", s.queries[1]["fromId"])
+}
+
+func (s *iteratorTestSuite) TestTrades() {
+	s.mockDoOnce([]byte(`[{"id":5},{"id":6}]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`[{"id":6},{"id":7}]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`[]`), nil, http.StatusOK)
+	s.recordReqs()
+
+	it := s.client.NewListTradesService().Symbol("LTCBTC").Limit(2).Iterate(newContext())
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	r := s.r()
+	r.NoError(it.Err())
+	r.Equal([]int64{5, 6, 7}, ids)
+	r.Len(s.queries, 3)
+	r.Equal("0", s.queries[0]["fromId"])
+	r.Equal("7", s.queries[1]["fromId"])
+	r.Equal("8", s.queries[2]["fromId"])
+}
+
+func (s *iteratorTestSuite) TestHistoricalTrades() {
+	s.mockDoOnce([]byte(`[{"id":10},{"id":11}]`), nil, http.StatusOK)
+	s.recordReqs()
+
+	it := s.client.NewHistoricalTradesService().Symbol("LTCBTC").FromID(10).Iterate(newContext())
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	r := s.r()
+	r.NoError(it.Err())
+	r.Equal([]int64{10, 11}, ids)
+	r.Len(s.queries, 1)
+	r.Equal("1000", s.queries[0]["limit"])
+}
+
+func (s *iteratorTestSuite) TestOrders() {
+	s.mockDoOnce([]byte(`[{"orderId":1},{"orderId":2}]`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`{"code":-1003,"msg":"too many requests"}`), nil, http.StatusBadRequest)
+	s.recordReqs()
+
+	it := s.client.NewListOrdersService().Symbol("LTCBTC").Limit(2).Iterate(newContext())
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Value().OrderID)
+	}
+	r := s.r()
+	r.Equal([]int64{1, 2}, ids)
+	r.Error(it.Err())
+	r.Equal("3", s.queries[1]["orderId"])
+	r.False(it.Next())
+}
+
+func (s *iteratorTestSuite) TestCancel() {
+	s.mockDoOnce([]byte(`[{"id":1},{"id":2}]`), nil, http.StatusOK)
+	s.recordReqs()
+
+	ctx, cancel := context.WithCancel(newContext())
+	it := s.client.NewListTradesService().Symbol("LTCBTC").Limit(2).Iterate(ctx)
+	r := s.r()
+	r.True(it.Next())
+	cancel()
+	r.True(it.Next())
+	r.False(it.Next())
+	r.Equal(context.Canceled, it.Err())
+	r.Len(s.queries, 1)
+}
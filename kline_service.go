@@ -3,13 +3,14 @@ package binance
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // KlinesService list klines
 type KlinesService struct {
 	c         *Client
 	symbol    string
-	interval  string
+	interval  KlineInterval
 	limit     *int
 	startTime *int64
 	endTime   *int64
@@ -22,7 +23,7 @@ func (s *KlinesService) Symbol(symbol string) *KlinesService {
 }
 
 // Interval set interval
-func (s *KlinesService) Interval(interval string) *KlinesService {
+func (s *KlinesService) Interval(interval KlineInterval) *KlinesService {
 	s.interval = interval
 	return s
 }
@@ -45,8 +46,21 @@ func (s *KlinesService) EndTime(endTime int64) *KlinesService {
 	return s
 }
 
+// Since set startTime from t
+func (s *KlinesService) Since(t time.Time) *KlinesService {
+	return s.StartTime(TimeToMillis(t))
+}
+
+// Until set endTime from t
+func (s *KlinesService) Until(t time.Time) *KlinesService {
+	return s.EndTime(TimeToMillis(t))
+}
+
 // Do send request
 func (s *KlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	if err = s.interval.Validate(); err != nil {
+		return
+	}
 	r := &request{
 		method:   "GET",
 		endpoint: "/api/v1/klines",
@@ -109,3 +123,53 @@ type Kline struct {
 	TakerBuyBaseAssetVolume  string `json:"takerBuyBaseAssetVolume"`
 	TakerBuyQuoteAssetVolume string `json:"takerBuyQuoteAssetVolume"`
 }
+
+// OpenTimeUTC return open time as a UTC time
+func (k *Kline) OpenTimeUTC() time.Time {
+	return MillisToTime(k.OpenTime)
+}
+
+// CloseTimeUTC return close time as a UTC time
+func (k *Kline) CloseTimeUTC() time.Time {
+	return MillisToTime(k.CloseTime)
+}
+
+// OpenDecimal return open as a decimal, zero if it is empty or malformed
+func (k *Kline) OpenDecimal() Decimal {
+	return parseDecimalOrZero(k.Open)
+}
+
+// HighDecimal return high as a decimal, zero if it is empty or malformed
+func (k *Kline) HighDecimal() Decimal {
+	return parseDecimalOrZero(k.High)
+}
+
+// LowDecimal return low as a decimal, zero if it is empty or malformed
+func (k *Kline) LowDecimal() Decimal {
+	return parseDecimalOrZero(k.Low)
+}
+
+// CloseDecimal return close as a decimal, zero if it is empty or malformed
+func (k *Kline) CloseDecimal() Decimal {
+	return parseDecimalOrZero(k.Close)
+}
+
+// VolumeDecimal return volume as a decimal, zero if it is empty or malformed
+func (k *Kline) VolumeDecimal() Decimal {
+	return parseDecimalOrZero(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal return quote asset volume as a decimal, zero if it is empty or malformed
+func (k *Kline) QuoteAssetVolumeDecimal() Decimal {
+	return parseDecimalOrZero(k.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal return taker buy base asset volume as a decimal, zero if it is empty or malformed
+func (k *Kline) TakerBuyBaseAssetVolumeDecimal() Decimal {
+	return parseDecimalOrZero(k.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal return taker buy quote asset volume as a decimal, zero if it is empty or malformed
+func (k *Kline) TakerBuyQuoteAssetVolumeDecimal() Decimal {
+	return parseDecimalOrZero(k.TakerBuyQuoteAssetVolume)
+}
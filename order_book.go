@@ -0,0 +1,358 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	orderBookMaxBuffered = 1000
+	orderBookRetryDelay  = time.Second
+)
+
+// ErrOrderBookStale is returned when the snapshot is older than the first
+// buffered event, a newer snapshot is needed
+var ErrOrderBookStale = errors.New("binance: order book snapshot is older than buffered events")
+
+// OrderBookLevel define the quantity at a price of the book, a zero quantity
+// in an update means the level was removed
+type OrderBookLevel struct {
+	Price    Decimal
+	Quantity Decimal
+}
+
+// OrderBookUpdate define a change of the book notified to the handlers
+type OrderBookUpdate struct {
+	Symbol   string
+	UpdateID int64
+	// Synced is false when a gap was detected and the book waits for a new snapshot
+	Synced bool
+	// Snapshot is true when the book was reset from a snapshot, Bids and Asks
+	// are then empty
+	Snapshot bool
+	Bids     []OrderBookLevel
+	Asks     []OrderBookLevel
+}
+
+// OrderBookHandler handle order book updates
+type OrderBookHandler func(update *OrderBookUpdate)
+
+// OrderBook maintain a local order book from the diff depth stream and REST
+// snapshots. Events are passed to Update, usually from the handler of
+// WsDiffDepthServe, and Start fetch a snapshot whenever the book is out of
+// sync. It is safe for concurrent use.
+type OrderBook struct {
+	c      *Client
+	symbol string
+	limit  int
+
+	mu           sync.RWMutex
+	bids         []OrderBookLevel // by descending price
+	asks         []OrderBookLevel // by ascending price
+	lastUpdateID int64
+	synced       bool
+	// firstEvent is true until an event is applied after the snapshot
+	firstEvent bool
+	buffer     []*WsDiffDepthEvent
+	resync     chan struct{}
+
+	handlersMu sync.Mutex
+	handlers   map[int]OrderBookHandler
+	nextID     int
+	retryDelay time.Duration
+}
+
+// NewOrderBook init an order book of symbol, it is empty until synced
+func (c *Client) NewOrderBook(symbol string) *OrderBook {
+	return &OrderBook{
+		c:          c,
+		symbol:     symbol,
+		limit:      1000,
+		resync:     make(chan struct{}, 1),
+		handlers:   make(map[int]OrderBookHandler),
+		retryDelay: orderBookRetryDelay,
+	}
+}
+
+// Limit set the number of levels of the snapshots
+func (ob *OrderBook) Limit(limit int) *OrderBook {
+	ob.limit = limit
+	return ob
+}
+
+// Symbol return the symbol of the book
+func (ob *OrderBook) Symbol() string {
+	return ob.symbol
+}
+
+// Subscribe register handler for book changes and return a function
+// removing it. Handlers are called one at a time.
+func (ob *OrderBook) Subscribe(handler OrderBookHandler) (unsubscribe func()) {
+	ob.handlersMu.Lock()
+	defer ob.handlersMu.Unlock()
+	id := ob.nextID
+	ob.nextID++
+	ob.handlers[id] = handler
+	return func() {
+		ob.handlersMu.Lock()
+		defer ob.handlersMu.Unlock()
+		delete(ob.handlers, id)
+	}
+}
+
+func (ob *OrderBook) notify(update *OrderBookUpdate) {
+	ob.handlersMu.Lock()
+	defer ob.handlersMu.Unlock()
+	for id := 0; id < ob.nextID; id++ {
+		if h, ok := ob.handlers[id]; ok {
+			h(update)
+		}
+	}
+}
+
+// Start sync the book in background until ctx is done. A snapshot is
+// fetched at start and after every gap, errors are passed to errHandler and
+// the sync is retried.
+func (ob *OrderBook) Start(ctx context.Context, errHandler func(err error)) {
+	go func() {
+		for {
+			err := ob.Sync(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				if errHandler != nil {
+					errHandler(err)
+				}
+				if sleepContext(ctx, ob.retryDelay) != nil {
+					return
+				}
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ob.resync:
+			}
+		}
+	}()
+}
+
+// Sync fetch a snapshot and apply the buffered events, it does nothing if
+// the book is already synced
+func (ob *OrderBook) Sync(ctx context.Context) error {
+	if ob.Synced() {
+		return nil
+	}
+	svc := ob.c.NewDepthService().Symbol(ob.symbol)
+	if ob.limit > 0 {
+		svc.Limit(ob.limit)
+	}
+	snapshot, err := svc.Do(ctx)
+	if err != nil {
+		return err
+	}
+	updates, err := ob.applySnapshot(snapshot)
+	for _, update := range updates {
+		ob.notify(update)
+	}
+	return err
+}
+
+// applySnapshot reset the book to snapshot and apply the buffered events
+// following it
+func (ob *OrderBook) applySnapshot(snapshot *DepthResponse) ([]*OrderBookUpdate, error) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	if ob.synced {
+		return nil, nil
+	}
+	// Binance requires to open the stream before fetching the snapshot
+	if len(ob.buffer) > 0 && ob.buffer[0].FirstUpdateID > snapshot.LastUpdateID+1 {
+		return nil, ErrOrderBookStale
+	}
+	ob.bids = ob.bids[:0]
+	for _, b := range snapshot.Bids {
+		ob.bids = setOrderBookLevel(ob.bids, b.PriceDecimal(), b.QuantityDecimal(), true)
+	}
+	ob.asks = ob.asks[:0]
+	for _, a := range snapshot.Asks {
+		ob.asks = setOrderBookLevel(ob.asks, a.PriceDecimal(), a.QuantityDecimal(), false)
+	}
+	ob.lastUpdateID = snapshot.LastUpdateID
+	ob.synced = true
+	ob.firstEvent = true
+	updates := []*OrderBookUpdate{{
+		Symbol:   ob.symbol,
+		UpdateID: ob.lastUpdateID,
+		Synced:   true,
+		Snapshot: true,
+	}}
+
+	buffer := ob.buffer
+	ob.buffer = nil
+	for i, event := range buffer {
+		update, ok := ob.apply(event)
+		if !ok {
+			ob.outOfSync(buffer[i:])
+			return append(updates, update), nil
+		}
+		if update != nil {
+			updates = append(updates, update)
+		}
+	}
+	return updates, nil
+}
+
+// Update apply a diff depth event. Events are buffered until the book is
+// synced and a gap in the update IDs triggers a resync.
+func (ob *OrderBook) Update(event *WsDiffDepthEvent) {
+	if event.Symbol != "" && !strings.EqualFold(event.Symbol, ob.symbol) {
+		return
+	}
+	ob.mu.Lock()
+	if !ob.synced {
+		ob.buffer = append(ob.buffer, event)
+		if len(ob.buffer) > orderBookMaxBuffered {
+			ob.buffer = ob.buffer[len(ob.buffer)-orderBookMaxBuffered:]
+		}
+		ob.mu.Unlock()
+		return
+	}
+	update, ok := ob.apply(event)
+	if !ok {
+		ob.outOfSync([]*WsDiffDepthEvent{event})
+	}
+	ob.mu.Unlock()
+	if update != nil {
+		ob.notify(update)
+	}
+}
+
+// apply an event to a synced book, it returns false on a gap. Events older
+// than the book are ignored.
+func (ob *OrderBook) apply(event *WsDiffDepthEvent) (*OrderBookUpdate, bool) {
+	if event.UpdateID <= ob.lastUpdateID {
+		return nil, true
+	}
+	if event.FirstUpdateID > ob.lastUpdateID+1 || (!ob.firstEvent && event.FirstUpdateID != ob.lastUpdateID+1) {
+		return &OrderBookUpdate{Symbol: ob.symbol, UpdateID: ob.lastUpdateID}, false
+	}
+	update := &OrderBookUpdate{
+		Symbol:   ob.symbol,
+		UpdateID: event.UpdateID,
+		Synced:   true,
+		Bids:     make([]OrderBookLevel, len(event.Bids)),
+		Asks:     make([]OrderBookLevel, len(event.Asks)),
+	}
+	for i, b := range event.Bids {
+		level := OrderBookLevel{Price: b.PriceDecimal(), Quantity: b.QuantityDecimal()}
+		ob.bids = setOrderBookLevel(ob.bids, level.Price, level.Quantity, true)
+		update.Bids[i] = level
+	}
+	for i, a := range event.Asks {
+		level := OrderBookLevel{Price: a.PriceDecimal(), Quantity: a.QuantityDecimal()}
+		ob.asks = setOrderBookLevel(ob.asks, level.Price, level.Quantity, false)
+		update.Asks[i] = level
+	}
+	ob.lastUpdateID = event.UpdateID
+	ob.firstEvent = false
+	return update, true
+}
+
+// outOfSync mark the book as unsynced, keep events to apply after the next
+// snapshot and wake up Start
+func (ob *OrderBook) outOfSync(events []*WsDiffDepthEvent) {
+	ob.synced = false
+	ob.buffer = append(ob.buffer[:0], events...)
+	select {
+	case ob.resync <- struct{}{}:
+	default:
+	}
+}
+
+// setOrderBookLevel set the quantity at price in levels sorted by descending
+// price for bids, ascending for asks, a zero quantity remove the level
+func setOrderBookLevel(levels []OrderBookLevel, price, quantity Decimal, bids bool) []OrderBookLevel {
+	i := sort.Search(len(levels), func(i int) bool {
+		if bids {
+			return levels[i].Price.Cmp(price) <= 0
+		}
+		return levels[i].Price.Cmp(price) >= 0
+	})
+	found := i < len(levels) && levels[i].Price.Equal(price)
+	switch {
+	case quantity.IsZero() && found:
+		return append(levels[:i], levels[i+1:]...)
+	case quantity.IsZero():
+		return levels
+	case found:
+		levels[i].Quantity = quantity
+		return levels
+	}
+	levels = append(levels, OrderBookLevel{})
+	copy(levels[i+1:], levels[i:])
+	levels[i] = OrderBookLevel{Price: price, Quantity: quantity}
+	return levels
+}
+
+// Synced check if the book is in sync with the exchange
+func (ob *OrderBook) Synced() bool {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+	return ob.synced
+}
+
+// LastUpdateID return the ID of the last update applied
+func (ob *OrderBook) LastUpdateID() int64 {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+	return ob.lastUpdateID
+}
+
+// BestBid return the highest bid, false if there is none
+func (ob *OrderBook) BestBid() (OrderBookLevel, bool) {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+	if len(ob.bids) == 0 {
+		return OrderBookLevel{}, false
+	}
+	return ob.bids[0], true
+}
+
+// BestAsk return the lowest ask, false if there is none
+func (ob *OrderBook) BestAsk() (OrderBookLevel, bool) {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+	if len(ob.asks) == 0 {
+		return OrderBookLevel{}, false
+	}
+	return ob.asks[0], true
+}
+
+// Bids return a copy of the n best bids, all of them if n <= 0
+func (ob *OrderBook) Bids(n int) []OrderBookLevel {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+	return copyOrderBookLevels(ob.bids, n)
+}
+
+// Asks return a copy of the n best asks, all of them if n <= 0
+func (ob *OrderBook) Asks(n int) []OrderBookLevel {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+	return copyOrderBookLevels(ob.asks, n)
+}
+
+func copyOrderBookLevels(levels []OrderBookLevel, n int) []OrderBookLevel {
+	if n <= 0 || n > len(levels) {
+		n = len(levels)
+	}
+	res := make([]OrderBookLevel, n)
+	copy(res, levels)
+	return res
+}
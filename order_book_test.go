@@ -0,0 +1,173 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderBookTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+const orderBookSnapshotJSON = `{
+	"lastUpdateId": 100,
+	"bids": [["0.0024", "10"], ["0.0022", "5"], ["0.0023", "8"]],
+	"asks": [["0.0026", "100"], ["0.0025", "7"]]
+}`
+
+func (s *orderBookTestSuite) assertLevels(e []string, levels []OrderBookLevel) {
+	a := make([]string, len(levels))
+	for i, l := range levels {
+		a[i] = l.Price.String() + "@" + l.Quantity.String()
+	}
+	s.r().Equal(e, a)
+}
+
+func (s *orderBookTestSuite) TestSync() {
+	s.mockDoOnce([]byte(orderBookSnapshotJSON), nil, http.StatusOK)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{"symbol": "LTCBTC", "limit": 1000})
+		s.assertRequestEqual(e, r)
+	})
+
+	book := s.client.NewOrderBook("LTCBTC")
+	var updates []*OrderBookUpdate
+	book.Subscribe(func(update *OrderBookUpdate) {
+		updates = append(updates, update)
+	})
+	r := s.r()
+	r.False(book.Synced())
+	_, ok := book.BestBid()
+	r.False(ok)
+
+	// buffered before the snapshot, the first one is dropped and the second straddles it
+	book.Update(&WsDiffDepthEvent{Symbol: "LTCBTC", FirstUpdateID: 95, UpdateID: 99, Bids: []Bid{{"0.0030", "1"}}})
+	book.Update(&WsDiffDepthEvent{Symbol: "LTCBTC", FirstUpdateID: 99, UpdateID: 102, Bids: []Bid{{"0.0024", "0"}}})
+	book.Update(&WsDiffDepthEvent{Symbol: "ETHBTC", FirstUpdateID: 103, UpdateID: 103})
+	r.NoError(book.Sync(newContext()))
+	r.True(book.Synced())
+	r.EqualValues(102, book.LastUpdateID())
+	s.assertLevels([]string{"0.0023@8", "0.0022@5"}, book.Bids(0))
+	s.assertLevels([]string{"0.0025@7", "0.0026@100"}, book.Asks(0))
+
+	book.Update(&WsDiffDepthEvent{FirstUpdateID: 103, UpdateID: 104,
+		Bids: []Bid{{"0.00225", "3"}}, Asks: []Ask{{"0.0025", "2"}, {"0.0027", "1"}}})
+	r.EqualValues(104, book.LastUpdateID())
+	s.assertLevels([]string{"0.0023@8", "0.00225@3"}, book.Bids(2))
+	s.assertLevels([]string{"0.0025@2", "0.0026@100", "0.0027@1"}, book.Asks(5))
+	bid, ok := book.BestBid()
+	r.True(ok)
+	r.Equal("0.0023", bid.Price.String())
+	ask, ok := book.BestAsk()
+	r.True(ok)
+	r.Equal("2", ask.Quantity.String())
+
+	r.Len(updates, 3)
+	r.True(updates[0].Snapshot)
+	r.EqualValues(100, updates[0].UpdateID)
+	r.EqualValues(102, updates[1].UpdateID)
+	r.Len(updates[2].Asks, 2)
+	r.True(updates[2].Synced)
+
+	// already synced
+	r.NoError(book.Sync(newContext()))
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *orderBookTestSuite) TestStale() {
+	s.mockDoOnce([]byte(orderBookSnapshotJSON), nil, http.StatusOK)
+
+	book := s.client.NewOrderBook("LTCBTC")
+	book.Update(&WsDiffDepthEvent{FirstUpdateID: 102, UpdateID: 105})
+	r := s.r()
+	r.Equal(ErrOrderBookStale, book.Sync(newContext()))
+	r.False(book.Synced())
+}
+
+func (s *orderBookTestSuite) TestGap() {
+	s.mockDoOnce([]byte(orderBookSnapshotJSON), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`{"lastUpdateId": 110, "bids": [["0.0020", "1"]], "asks": []}`), nil, http.StatusOK)
+
+	book := s.client.NewOrderBook("LTCBTC")
+	var updates []*OrderBookUpdate
+	book.Subscribe(func(update *OrderBookUpdate) {
+		updates = append(updates, update)
+	})
+	r := s.r()
+	r.NoError(book.Sync(newContext()))
+	book.Update(&WsDiffDepthEvent{FirstUpdateID: 101, UpdateID: 101})
+	// 102 to 104 are missing
+	book.Update(&WsDiffDepthEvent{FirstUpdateID: 105, UpdateID: 108})
+	r.False(book.Synced())
+	r.False(updates[len(updates)-1].Synced)
+	r.Len(book.resync, 1)
+	book.Update(&WsDiffDepthEvent{FirstUpdateID: 109, UpdateID: 111, Asks: []Ask{{"0.0021", "4"}}})
+
+	r.NoError(book.Sync(newContext()))
+	r.True(book.Synced())
+	r.EqualValues(111, book.LastUpdateID())
+	s.assertLevels([]string{"0.0020@1"}, book.Bids(0))
+	s.assertLevels([]string{"0.0021@4"}, book.Asks(0))
+}
+
+func (s *orderBookTestSuite) TestFirstEventAfterSnapshot() {
+	s.mockDoOnce([]byte(orderBookSnapshotJSON), nil, http.StatusOK)
+
+	book := s.client.NewOrderBook("LTCBTC")
+	r := s.r()
+	r.NoError(book.Sync(newContext()))
+	book.Update(&WsDiffDepthEvent{FirstUpdateID: 90, UpdateID: 100})
+	r.True(book.Synced())
+	book.Update(&WsDiffDepthEvent{FirstUpdateID: 98, UpdateID: 103})
+	r.True(book.Synced())
+	r.EqualValues(103, book.LastUpdateID())
+	book.Update(&WsDiffDepthEvent{FirstUpdateID: 103, UpdateID: 104})
+	r.False(book.Synced())
+}
+
+func (s *orderBookTestSuite) TestStart() {
+	s.mockDoOnce([]byte(`{"code":-1003,"msg":"too many requests"}`), nil, http.StatusBadRequest)
+	s.mockDoOnce([]byte(orderBookSnapshotJSON), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`{"lastUpdateId": 200, "bids": [], "asks": []}`), nil, http.StatusOK)
+
+	book := s.client.NewOrderBook("LTCBTC")
+	book.retryDelay = time.Millisecond
+	synced := make(chan int64, 10)
+	book.Subscribe(func(update *OrderBookUpdate) {
+		if update.Snapshot {
+			synced <- update.UpdateID
+		}
+	})
+	errs := make(chan error, 10)
+	ctx, cancel := context.WithCancel(newContext())
+	defer cancel()
+	book.Start(ctx, func(err error) {
+		errs <- err
+	})
+
+	r := s.r()
+	r.Error(<-errs)
+	r.EqualValues(100, <-synced)
+	book.Update(&WsDiffDepthEvent{FirstUpdateID: 150, UpdateID: 199})
+	r.EqualValues(200, <-synced)
+	r.True(book.Synced())
+}
+
+func (s *orderBookTestSuite) TestSetLevel() {
+	var asks []OrderBookLevel
+	for _, p := range []string{"3", "1", "2", "1"} {
+		asks = setOrderBookLevel(asks, MustParseDecimal(p), MustParseDecimal(p), false)
+	}
+	asks = setOrderBookLevel(asks, MustParseDecimal("5"), Decimal{}, false)
+	s.assertLevels([]string{"1@1", "2@2", "3@3"}, asks)
+	asks = setOrderBookLevel(asks, MustParseDecimal("2.0"), Decimal{}, false)
+	s.assertLevels([]string{"1@1", "3@3"}, asks)
+}
@@ -0,0 +1,439 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ContingencyType define contingency type of order list
+type ContingencyType string
+
+// ListStatusType define status type of order list
+type ListStatusType string
+
+// ListOrderStatusType define order status of order list
+type ListOrderStatusType string
+
+// Order list enums
+const (
+	ContingencyTypeOCO ContingencyType = "OCO"
+
+	ListStatusTypeResponse    ListStatusType = "RESPONSE"
+	ListStatusTypeExecStarted ListStatusType = "EXEC_STARTED"
+	ListStatusTypeAllDone     ListStatusType = "ALL_DONE"
+
+	ListOrderStatusTypeExecuting ListOrderStatusType = "EXECUTING"
+	ListOrderStatusTypeAllDone   ListOrderStatusType = "ALL_DONE"
+	ListOrderStatusTypeReject    ListOrderStatusType = "REJECT"
+)
+
+// CreateOCOService create an OCO order list pairing a limit order with a
+// stop loss order
+type CreateOCOService struct {
+	c                    *Client
+	symbol               string
+	listClientOrderID    *string
+	side                 SideType
+	quantity             string
+	limitClientOrderID   *string
+	price                string
+	limitIcebergQuantity *string
+	stopClientOrderID    *string
+	stopPrice            string
+	stopLimitPrice       *string
+	stopIcebergQuantity  *string
+	stopLimitTimeInForce *TimeInForce
+	newOrderRespType     *NewOrderRespType
+}
+
+// Symbol set symbol
+func (s *CreateOCOService) Symbol(symbol string) *CreateOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CreateOCOService) ListClientOrderID(listClientOrderID string) *CreateOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// Side set side
+func (s *CreateOCOService) Side(side SideType) *CreateOCOService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateOCOService) Quantity(quantity string) *CreateOCOService {
+	s.quantity = quantity
+	return s
+}
+
+// LimitClientOrderID set limitClientOrderID
+func (s *CreateOCOService) LimitClientOrderID(limitClientOrderID string) *CreateOCOService {
+	s.limitClientOrderID = &limitClientOrderID
+	return s
+}
+
+// Price set price of the limit order
+func (s *CreateOCOService) Price(price string) *CreateOCOService {
+	s.price = price
+	return s
+}
+
+// LimitIcebergQuantity set limitIcebergQuantity
+func (s *CreateOCOService) LimitIcebergQuantity(limitIcebergQuantity string) *CreateOCOService {
+	s.limitIcebergQuantity = &limitIcebergQuantity
+	return s
+}
+
+// StopClientOrderID set stopClientOrderID
+func (s *CreateOCOService) StopClientOrderID(stopClientOrderID string) *CreateOCOService {
+	s.stopClientOrderID = &stopClientOrderID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CreateOCOService) StopPrice(stopPrice string) *CreateOCOService {
+	s.stopPrice = stopPrice
+	return s
+}
+
+// StopLimitPrice set stopLimitPrice, the stop order is a STOP_LOSS_LIMIT
+// order when set and a STOP_LOSS order otherwise
+func (s *CreateOCOService) StopLimitPrice(stopLimitPrice string) *CreateOCOService {
+	s.stopLimitPrice = &stopLimitPrice
+	return s
+}
+
+// StopIcebergQuantity set stopIcebergQuantity
+func (s *CreateOCOService) StopIcebergQuantity(stopIcebergQuantity string) *CreateOCOService {
+	s.stopIcebergQuantity = &stopIcebergQuantity
+	return s
+}
+
+// StopLimitTimeInForce set stopLimitTimeInForce
+func (s *CreateOCOService) StopLimitTimeInForce(stopLimitTimeInForce TimeInForce) *CreateOCOService {
+	s.stopLimitTimeInForce = &stopLimitTimeInForce
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// Do send request
+func (s *CreateOCOService) Do(ctx context.Context, opts ...RequestOption) (res *OrderList, err error) {
+	r := &request{
+		method:   "POST",
+		endpoint: "/api/v3/order/oco",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":    s.symbol,
+		"side":      s.side,
+		"quantity":  s.quantity,
+		"price":     s.price,
+		"stopPrice": s.stopPrice,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.limitClientOrderID != nil {
+		m["limitClientOrderId"] = *s.limitClientOrderID
+	}
+	if s.limitIcebergQuantity != nil {
+		m["limitIcebergQty"] = *s.limitIcebergQuantity
+	}
+	if s.stopClientOrderID != nil {
+		m["stopClientOrderId"] = *s.stopClientOrderID
+	}
+	if s.stopLimitPrice != nil {
+		m["stopLimitPrice"] = *s.stopLimitPrice
+	}
+	if s.stopIcebergQuantity != nil {
+		m["stopIcebergQty"] = *s.stopIcebergQuantity
+	}
+	if s.stopLimitTimeInForce != nil {
+		m["stopLimitTimeInForce"] = *s.stopLimitTimeInForce
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	r.setFormParams(m)
+	return s.c.callOrderListAPI(ctx, r, opts...)
+}
+
+// CancelOrderListService cancel an entire order list
+type CancelOrderListService struct {
+	c                 *Client
+	symbol            string
+	orderListID       *int64
+	listClientOrderID *string
+	newClientOrderID  *string
+}
+
+// Symbol set symbol
+func (s *CancelOrderListService) Symbol(symbol string) *CancelOrderListService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderListID set orderListID
+func (s *CancelOrderListService) OrderListID(orderListID int64) *CancelOrderListService {
+	s.orderListID = &orderListID
+	return s
+}
+
+// ListClientOrderID set listClientOrderID
+func (s *CancelOrderListService) ListClientOrderID(listClientOrderID string) *CancelOrderListService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CancelOrderListService) NewClientOrderID(newClientOrderID string) *CancelOrderListService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// Do send request
+func (s *CancelOrderListService) Do(ctx context.Context, opts ...RequestOption) (res *OrderList, err error) {
+	r := &request{
+		method:   "DELETE",
+		endpoint: "/api/v3/orderList",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("symbol", s.symbol)
+	if s.orderListID != nil {
+		r.setFormParam("orderListId", *s.orderListID)
+	}
+	if s.listClientOrderID != nil {
+		r.setFormParam("listClientOrderId", *s.listClientOrderID)
+	}
+	if s.newClientOrderID != nil {
+		r.setFormParam("newClientOrderId", *s.newClientOrderID)
+	}
+	return s.c.callOrderListAPI(ctx, r, opts...)
+}
+
+// GetOrderListService get an order list
+type GetOrderListService struct {
+	c                 *Client
+	orderListID       *int64
+	origClientOrderID *string
+}
+
+// OrderListID set orderListID
+func (s *GetOrderListService) OrderListID(orderListID int64) *GetOrderListService {
+	s.orderListID = &orderListID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID, the listClientOrderId of the order list
+func (s *GetOrderListService) OrigClientOrderID(origClientOrderID string) *GetOrderListService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Do send request
+func (s *GetOrderListService) Do(ctx context.Context, opts ...RequestOption) (res *OrderList, err error) {
+	r := &request{
+		method:   "GET",
+		endpoint: "/api/v3/orderList",
+		secType:  secTypeSigned,
+	}
+	if s.orderListID != nil {
+		r.setParam("orderListId", *s.orderListID)
+	}
+	if s.origClientOrderID != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderID)
+	}
+	return s.c.callOrderListAPI(ctx, r, opts...)
+}
+
+// ListOrderListsService list all order lists
+type ListOrderListsService struct {
+	c         *Client
+	fromID    *int64
+	startTime *int64
+	endTime   *int64
+	limit     *int
+}
+
+// FromID set fromID
+func (s *ListOrderListsService) FromID(fromID int64) *ListOrderListsService {
+	s.fromID = &fromID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListOrderListsService) StartTime(startTime int64) *ListOrderListsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListOrderListsService) EndTime(endTime int64) *ListOrderListsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Since set startTime from t
+func (s *ListOrderListsService) Since(t time.Time) *ListOrderListsService {
+	return s.StartTime(TimeToMillis(t))
+}
+
+// Until set endTime from t
+func (s *ListOrderListsService) Until(t time.Time) *ListOrderListsService {
+	return s.EndTime(TimeToMillis(t))
+}
+
+// Limit set limit
+func (s *ListOrderListsService) Limit(limit int) *ListOrderListsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListOrderListsService) Do(ctx context.Context, opts ...RequestOption) (res []*OrderList, err error) {
+	r := &request{
+		method:   "GET",
+		endpoint: "/api/v3/allOrderList",
+		secType:  secTypeSigned,
+	}
+	if s.fromID != nil {
+		r.setParam("fromId", *s.fromID)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	return s.c.callOrderListsAPI(ctx, r, opts...)
+}
+
+// ListOpenOrderListsService list open order lists
+type ListOpenOrderListsService struct {
+	c *Client
+}
+
+// Do send request
+func (s *ListOpenOrderListsService) Do(ctx context.Context, opts ...RequestOption) (res []*OrderList, err error) {
+	r := &request{
+		method:   "GET",
+		endpoint: "/api/v3/openOrderList",
+		secType:  secTypeSigned,
+	}
+	return s.c.callOrderListsAPI(ctx, r, opts...)
+}
+
+func (c *Client) callOrderListAPI(ctx context.Context, r *request, opts ...RequestOption) (res *OrderList, err error) {
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return
+	}
+	res = new(OrderList)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (c *Client) callOrderListsAPI(ctx context.Context, r *request, opts ...RequestOption) (res []*OrderList, err error) {
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return
+	}
+	res = make([]*OrderList, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// OrderList define order list info, OrderReports is only set by the
+// responses of CreateOCOService and CancelOrderListService
+type OrderList struct {
+	OrderListID       int64               `json:"orderListId"`
+	ContingencyType   ContingencyType     `json:"contingencyType"`
+	ListStatusType    ListStatusType      `json:"listStatusType"`
+	ListOrderStatus   ListOrderStatusType `json:"listOrderStatus"`
+	ListClientOrderID string              `json:"listClientOrderId"`
+	TransactionTime   int64               `json:"transactionTime"`
+	Symbol            string              `json:"symbol"`
+	Orders            []*OrderListOrder   `json:"orders"`
+	OrderReports      []*OrderReport      `json:"orderReports"`
+}
+
+// TransactionTimeUTC return transaction time as a UTC time
+func (o *OrderList) TransactionTimeUTC() time.Time {
+	return MillisToTime(o.TransactionTime)
+}
+
+// OrderListOrder define the identifiers of an order in an order list
+type OrderListOrder struct {
+	Symbol        string `json:"symbol"`
+	OrderID       int64  `json:"orderId"`
+	ClientOrderID string `json:"clientOrderId"`
+}
+
+// OrderReport define the state of an order of an order list
+type OrderReport struct {
+	Symbol                   string          `json:"symbol"`
+	OrigClientOrderID        string          `json:"origClientOrderId"`
+	OrderID                  int64           `json:"orderId"`
+	OrderListID              int64           `json:"orderListId"`
+	ClientOrderID            string          `json:"clientOrderId"`
+	TransactTime             int64           `json:"transactTime"`
+	Price                    string          `json:"price"`
+	OrigQuantity             string          `json:"origQty"`
+	ExecutedQuantity         string          `json:"executedQty"`
+	CummulativeQuoteQuantity string          `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType `json:"status"`
+	TimeInForce              TimeInForce     `json:"timeInForce"`
+	Type                     OrderType       `json:"type"`
+	Side                     SideType        `json:"side"`
+	StopPrice                string          `json:"stopPrice"`
+	IcebergQuantity          string          `json:"icebergQty"`
+}
+
+// TransactTimeUTC return transact time as a UTC time
+func (o *OrderReport) TransactTimeUTC() time.Time {
+	return MillisToTime(o.TransactTime)
+}
+
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (o *OrderReport) PriceDecimal() Decimal {
+	return parseDecimalOrZero(o.Price)
+}
+
+// OrigQuantityDecimal return orig quantity as a decimal, zero if it is empty or malformed
+func (o *OrderReport) OrigQuantityDecimal() Decimal {
+	return parseDecimalOrZero(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return executed quantity as a decimal, zero if it is empty or malformed
+func (o *OrderReport) ExecutedQuantityDecimal() Decimal {
+	return parseDecimalOrZero(o.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return cummulative quote quantity as a decimal, zero if it is empty or malformed
+func (o *OrderReport) CummulativeQuoteQuantityDecimal() Decimal {
+	return parseDecimalOrZero(o.CummulativeQuoteQuantity)
+}
+
+// StopPriceDecimal return stop price as a decimal, zero if it is empty or malformed
+func (o *OrderReport) StopPriceDecimal() Decimal {
+	return parseDecimalOrZero(o.StopPrice)
+}
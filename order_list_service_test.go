@@ -0,0 +1,239 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderListServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderListService(t *testing.T) {
+	suite.Run(t, new(orderListServiceTestSuite))
+}
+
+var orderListData = []byte(`{
+    "orderListId": 0,
+    "contingencyType": "OCO",
+    "listStatusType": "EXEC_STARTED",
+    "listOrderStatus": "EXECUTING",
+    "listClientOrderId": "JYVpp3F0f5CAG15DhtrqLp",
+    "transactionTime": 1563417480525,
+    "symbol": "LTCBTC",
+    "orders": [
+        {"symbol": "LTCBTC", "orderId": 2, "clientOrderId": "Kk7sqHb9J6mJWTMDVW7Vos"},
+        {"symbol": "LTCBTC", "orderId": 3, "clientOrderId": "xTXKaGYd4bluPVp78IVRvl"}
+    ],
+    "orderReports": [
+        {
+            "symbol": "LTCBTC",
+            "orderId": 2,
+            "orderListId": 0,
+            "clientOrderId": "Kk7sqHb9J6mJWTMDVW7Vos",
+            "transactTime": 1563417480525,
+            "price": "0.000000",
+            "origQty": "0.624363",
+            "executedQty": "0.000000",
+            "cummulativeQuoteQty": "0.000000",
+            "status": "NEW",
+            "timeInForce": "GTC",
+            "type": "STOP_LOSS",
+            "side": "BUY",
+            "stopPrice": "0.960664"
+        },
+        {
+            "symbol": "LTCBTC",
+            "orderId": 3,
+            "orderListId": 0,
+            "clientOrderId": "xTXKaGYd4bluPVp78IVRvl",
+            "transactTime": 1563417480525,
+            "price": "0.036435",
+            "origQty": "0.624363",
+            "executedQty": "0.000000",
+            "cummulativeQuoteQty": "0.000000",
+            "status": "NEW",
+            "timeInForce": "GTC",
+            "type": "LIMIT_MAKER",
+            "side": "BUY"
+        }
+    ]
+}`)
+
+func (s *orderListServiceTestSuite) TestCreateOCO() {
+	s.mockDo(orderListData, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":               "LTCBTC",
+			"side":                 SideTypeBuy,
+			"quantity":             "0.624363",
+			"price":                "0.036435",
+			"stopPrice":            "0.960664",
+			"stopLimitPrice":       "0.960665",
+			"stopLimitTimeInForce": TimeInForceGTC,
+			"listClientOrderId":    "JYVpp3F0f5CAG15DhtrqLp",
+			"limitClientOrderId":   "xTXKaGYd4bluPVp78IVRvl",
+			"stopClientOrderId":    "Kk7sqHb9J6mJWTMDVW7Vos",
+			"limitIcebergQty":      "0.1",
+			"stopIcebergQty":       "0.2",
+			"newOrderRespType":     NewOrderRespTypeFULL,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOCOService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Quantity("0.624363").Price("0.036435").StopPrice("0.960664").
+		StopLimitPrice("0.960665").StopLimitTimeInForce(TimeInForceGTC).
+		ListClientOrderID("JYVpp3F0f5CAG15DhtrqLp").LimitClientOrderID("xTXKaGYd4bluPVp78IVRvl").
+		StopClientOrderID("Kk7sqHb9J6mJWTMDVW7Vos").LimitIcebergQuantity("0.1").
+		StopIcebergQuantity("0.2").NewOrderRespType(NewOrderRespTypeFULL).Do(newContext())
+	s.r().NoError(err)
+	s.assertOrderListEqual(res)
+}
+
+func (s *orderListServiceTestSuite) assertOrderListEqual(res *OrderList) {
+	r := s.r()
+	r.Equal(int64(0), res.OrderListID)
+	r.Equal(ContingencyTypeOCO, res.ContingencyType)
+	r.Equal(ListStatusTypeExecStarted, res.ListStatusType)
+	r.Equal(ListOrderStatusTypeExecuting, res.ListOrderStatus)
+	r.Equal("JYVpp3F0f5CAG15DhtrqLp", res.ListClientOrderID)
+	r.Equal(int64(1563417480525), res.TransactionTime)
+	r.Equal("LTCBTC", res.Symbol)
+	r.Equal([]*OrderListOrder{
+		{Symbol: "LTCBTC", OrderID: 2, ClientOrderID: "Kk7sqHb9J6mJWTMDVW7Vos"},
+		{Symbol: "LTCBTC", OrderID: 3, ClientOrderID: "xTXKaGYd4bluPVp78IVRvl"},
+	}, res.Orders)
+	r.Len(res.OrderReports, 2)
+	r.Equal(&OrderReport{
+		Symbol:                   "LTCBTC",
+		OrderID:                  2,
+		ClientOrderID:            "Kk7sqHb9J6mJWTMDVW7Vos",
+		TransactTime:             1563417480525,
+		Price:                    "0.000000",
+		OrigQuantity:             "0.624363",
+		ExecutedQuantity:         "0.000000",
+		CummulativeQuoteQuantity: "0.000000",
+		Status:                   "NEW",
+		TimeInForce:              "GTC",
+		Type:                     "STOP_LOSS",
+		Side:                     "BUY",
+		StopPrice:                "0.960664",
+	}, res.OrderReports[0])
+	r.Equal("0.960664", res.OrderReports[0].StopPriceDecimal().String())
+	r.Equal("0.036435", res.OrderReports[1].PriceDecimal().String())
+}
+
+func (s *orderListServiceTestSuite) TestCancelOrderList() {
+	s.mockDo(orderListData, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":            "LTCBTC",
+			"orderListId":       0,
+			"listClientOrderId": "JYVpp3F0f5CAG15DhtrqLp",
+			"newClientOrderId":  "cancelMyList",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCancelOrderListService().Symbol("LTCBTC").OrderListID(0).
+		ListClientOrderID("JYVpp3F0f5CAG15DhtrqLp").NewClientOrderID("cancelMyList").Do(newContext())
+	s.r().NoError(err)
+	s.assertOrderListEqual(res)
+}
+
+func (s *orderListServiceTestSuite) TestGetOrderList() {
+	data := []byte(`{
+        "orderListId": 27,
+        "contingencyType": "OCO",
+        "listStatusType": "EXEC_STARTED",
+        "listOrderStatus": "EXECUTING",
+        "listClientOrderId": "h2USkA5YQpaXHPIrkd96xE",
+        "transactionTime": 1565245656253,
+        "symbol": "LTCBTC",
+        "orders": [
+            {"symbol": "LTCBTC", "orderId": 4, "clientOrderId": "qD1gy3kc3Gx0rihm9Y3xwS"},
+            {"symbol": "LTCBTC", "orderId": 5, "clientOrderId": "ARzZ9I00CPM8i3NhmU9Ega"}
+        ]
+    }`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"orderListId":       27,
+			"origClientOrderId": "h2USkA5YQpaXHPIrkd96xE",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetOrderListService().OrderListID(27).
+		OrigClientOrderID("h2USkA5YQpaXHPIrkd96xE").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(27), res.OrderListID)
+	r.Len(res.Orders, 2)
+	r.Equal(int64(5), res.Orders[1].OrderID)
+	r.Empty(res.OrderReports)
+}
+
+func (s *orderListServiceTestSuite) TestListOrderLists() {
+	data := []byte(`[
+        {
+            "orderListId": 29,
+            "contingencyType": "OCO",
+            "listStatusType": "ALL_DONE",
+            "listOrderStatus": "ALL_DONE",
+            "listClientOrderId": "amEEAXryFzFwYF1FeRpUoZ",
+            "transactionTime": 1565245913483,
+            "symbol": "LTCBTC",
+            "orders": [
+                {"symbol": "LTCBTC", "orderId": 4, "clientOrderId": "oD7aesZqjEGlZrbtRpy5zB"},
+                {"symbol": "LTCBTC", "orderId": 5, "clientOrderId": "Jr1h6xirOxgeJOUuYQS7V3"}
+            ]
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"fromId":    28,
+			"startTime": 1565245913000,
+			"endTime":   1565245914000,
+			"limit":     10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListOrderListsService().FromID(28).StartTime(1565245913000).
+		EndTime(1565245914000).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal(int64(29), res[0].OrderListID)
+	r.Equal(ListStatusTypeAllDone, res[0].ListStatusType)
+	r.Equal(ListOrderStatusTypeAllDone, res[0].ListOrderStatus)
+}
+
+func (s *orderListServiceTestSuite) TestListOpenOrderLists() {
+	data := []byte(`[
+        {
+            "orderListId": 31,
+            "contingencyType": "OCO",
+            "listStatusType": "EXEC_STARTED",
+            "listOrderStatus": "EXECUTING",
+            "listClientOrderId": "wuB13fmulKj3YjdqWEcsnp",
+            "transactionTime": 1565246080644,
+            "symbol": "LTCBTC",
+            "orders": []
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newSignedRequest(), r)
+	})
+	res, err := s.client.NewListOpenOrderListsService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal("wuB13fmulKj3YjdqWEcsnp", res[0].ListClientOrderID)
+}
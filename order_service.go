@@ -3,6 +3,7 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"time"
 )
 
 // CreateOrderService create order
@@ -17,6 +18,7 @@ type CreateOrderService struct {
 	newClientOrderID *string
 	stopPrice        *string
 	icebergQuantity  *string
+	newOrderRespType *NewOrderRespType
 }
 
 // Symbol set symbol
@@ -73,6 +75,12 @@ func (s *CreateOrderService) IcebergQuantity(icebergQuantity string) *CreateOrde
 	return s
 }
 
+// NewOrderRespType set newOrderRespType, FULL responses include the fills
+func (s *CreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
 func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
 		method:   "POST",
@@ -101,6 +109,9 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.icebergQuantity != nil {
 		m["icebergQty"] = *s.icebergQuantity
 	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
 	r.setFormParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -129,19 +140,108 @@ func (s *CreateOrderService) Test(ctx context.Context, opts ...RequestOption) (e
 	return
 }
 
-// CreateOrderResponse define create order response
+// CreateOrderResponse define create order response, the fields set depend
+// on the newOrderRespType of the request
 type CreateOrderResponse struct {
-	Symbol           string `json:"symbol"`
-	OrderID          int64  `json:"orderId"`
-	ClientOrderID    string `json:"clientOrderId"`
-	TransactTime     int64  `json:"transactTime"`
-	Price            string `json:"price"`
-	OrigQuantity     string `json:"origQty"`
-	ExecutedQuantity string `json:"executedQty"`
-	Status           string `json:"status"`
-	TimeInForce      string `json:"timeInForce"`
-	Type             string `json:"type"`
-	Side             string `json:"side"`
+	Symbol                   string          `json:"symbol"`
+	OrderID                  int64           `json:"orderId"`
+	ClientOrderID            string          `json:"clientOrderId"`
+	TransactTime             int64           `json:"transactTime"`
+	Price                    string          `json:"price"`
+	OrigQuantity             string          `json:"origQty"`
+	ExecutedQuantity         string          `json:"executedQty"`
+	CummulativeQuoteQuantity string          `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType `json:"status"`
+	TimeInForce              TimeInForce     `json:"timeInForce"`
+	Type                     OrderType       `json:"type"`
+	Side                     SideType        `json:"side"`
+	Fills                    []*Fill         `json:"fills"`
+}
+
+// TransactTimeUTC return transact time as a UTC time
+func (c *CreateOrderResponse) TransactTimeUTC() time.Time {
+	return MillisToTime(c.TransactTime)
+}
+
+// Fill define a trade filling an order, returned by FULL responses
+type Fill struct {
+	TradeID         int64  `json:"tradeId"`
+	Price           string `json:"price"`
+	Quantity        string `json:"qty"`
+	Commission      string `json:"commission"`
+	CommissionAsset string `json:"commissionAsset"`
+}
+
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (f *Fill) PriceDecimal() Decimal {
+	return parseDecimalOrZero(f.Price)
+}
+
+// QuantityDecimal return quantity as a decimal, zero if it is empty or malformed
+func (f *Fill) QuantityDecimal() Decimal {
+	return parseDecimalOrZero(f.Quantity)
+}
+
+// CommissionDecimal return commission as a decimal, zero if it is empty or malformed
+func (f *Fill) CommissionDecimal() Decimal {
+	return parseDecimalOrZero(f.Commission)
+}
+
+// avgPriceExtraPlaces is the number of decimals added to the price scale
+// when computing an average price
+const avgPriceExtraPlaces = 8
+
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (c *CreateOrderResponse) PriceDecimal() Decimal {
+	return parseDecimalOrZero(c.Price)
+}
+
+// OrigQuantityDecimal return orig quantity as a decimal, zero if it is empty or malformed
+func (c *CreateOrderResponse) OrigQuantityDecimal() Decimal {
+	return parseDecimalOrZero(c.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return executed quantity as a decimal, zero if it is empty or malformed
+func (c *CreateOrderResponse) ExecutedQuantityDecimal() Decimal {
+	return parseDecimalOrZero(c.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return cummulative quote quantity as a decimal, zero if it is empty or malformed
+func (c *CreateOrderResponse) CummulativeQuoteQuantityDecimal() Decimal {
+	return parseDecimalOrZero(c.CummulativeQuoteQuantity)
+}
+
+// AvgPrice return the average fill price weighted by quantity. It uses the
+// fills of FULL responses and the cummulative quote quantity otherwise, and
+// return zero if nothing was filled.
+func (c *CreateOrderResponse) AvgPrice() Decimal {
+	quote, quantity := Decimal{}, Decimal{}
+	scale := int32(0)
+	for _, f := range c.Fills {
+		price := f.PriceDecimal()
+		if price.Scale() > scale {
+			scale = price.Scale()
+		}
+		quote = quote.Add(price.Mul(f.QuantityDecimal()))
+		quantity = quantity.Add(f.QuantityDecimal())
+	}
+	if len(c.Fills) == 0 {
+		quote, quantity = c.CummulativeQuoteQuantityDecimal(), c.ExecutedQuantityDecimal()
+		scale = quote.Scale()
+	}
+	if quantity.IsZero() {
+		return Decimal{}
+	}
+	return quote.Div(quantity, scale+avgPriceExtraPlaces)
+}
+
+// Fees return the total commission of the fills by commission asset
+func (c *CreateOrderResponse) Fees() map[string]Decimal {
+	fees := make(map[string]Decimal)
+	for _, f := range c.Fills {
+		fees[f.CommissionAsset] = fees[f.CommissionAsset].Add(f.CommissionDecimal())
+	}
+	return fees
 }
 
 // ListOpenOrdersService list opened orders
@@ -230,19 +330,49 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 
 // Order define order info
 type Order struct {
-	Symbol           string `json:"symbol"`
-	OrderID          int64  `json:"orderId"`
-	ClientOrderID    string `json:"clientOrderId"`
-	Price            string `json:"price"`
-	OrigQuantity     string `json:"origQty"`
-	ExecutedQuantity string `json:"executedQty"`
-	Status           string `json:"status"`
-	TimeInForce      string `json:"timeInForce"`
-	Type             string `json:"type"`
-	Side             string `json:"side"`
-	StopPrice        string `json:"stopPrice"`
-	IcebergQuantity  string `json:"icebergQty"`
-	Time             int64  `json:"time"`
+	Symbol           string          `json:"symbol"`
+	OrderID          int64           `json:"orderId"`
+	ClientOrderID    string          `json:"clientOrderId"`
+	Price            string          `json:"price"`
+	OrigQuantity     string          `json:"origQty"`
+	ExecutedQuantity string          `json:"executedQty"`
+	Status           OrderStatusType `json:"status"`
+	TimeInForce      TimeInForce     `json:"timeInForce"`
+	Type             OrderType       `json:"type"`
+	Side             SideType        `json:"side"`
+	StopPrice        string          `json:"stopPrice"`
+	IcebergQuantity  string          `json:"icebergQty"`
+	Time             int64           `json:"time"`
+}
+
+// TimeUTC return time as a UTC time
+func (o *Order) TimeUTC() time.Time {
+	return MillisToTime(o.Time)
+}
+
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (o *Order) PriceDecimal() Decimal {
+	return parseDecimalOrZero(o.Price)
+}
+
+// OrigQuantityDecimal return orig quantity as a decimal, zero if it is empty or malformed
+func (o *Order) OrigQuantityDecimal() Decimal {
+	return parseDecimalOrZero(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return executed quantity as a decimal, zero if it is empty or malformed
+func (o *Order) ExecutedQuantityDecimal() Decimal {
+	return parseDecimalOrZero(o.ExecutedQuantity)
+}
+
+// StopPriceDecimal return stop price as a decimal, zero if it is empty or malformed
+func (o *Order) StopPriceDecimal() Decimal {
+	return parseDecimalOrZero(o.StopPrice)
+}
+
+// IcebergQuantityDecimal return iceberg quantity as a decimal, zero if it is empty or malformed
+func (o *Order) IcebergQuantityDecimal() Decimal {
+	return parseDecimalOrZero(o.IcebergQuantity)
 }
 
 // ListOrdersService list all orders
@@ -361,8 +491,115 @@ func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 
 // CancelOrderResponse define response of canceling order
 type CancelOrderResponse struct {
-	Symbol            string `json:"symbol"`
-	OrigClientOrderID string `json:"origClientOrderId"`
-	OrderID           int64  `json:"orderId"`
-	ClientOrderID     string `json:"clientOrderId"`
+	Symbol                   string          `json:"symbol"`
+	OrigClientOrderID        string          `json:"origClientOrderId"`
+	OrderID                  int64           `json:"orderId"`
+	OrderListID              int64           `json:"orderListId"`
+	ClientOrderID            string          `json:"clientOrderId"`
+	TransactTime             int64           `json:"transactTime"`
+	Price                    string          `json:"price"`
+	OrigQuantity             string          `json:"origQty"`
+	ExecutedQuantity         string          `json:"executedQty"`
+	CummulativeQuoteQuantity string          `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType `json:"status"`
+	TimeInForce              TimeInForce     `json:"timeInForce"`
+	Type                     OrderType       `json:"type"`
+	Side                     SideType        `json:"side"`
+}
+
+// TransactTimeUTC return transact time as a UTC time
+func (c *CancelOrderResponse) TransactTimeUTC() time.Time {
+	return MillisToTime(c.TransactTime)
+}
+
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (c *CancelOrderResponse) PriceDecimal() Decimal {
+	return parseDecimalOrZero(c.Price)
+}
+
+// OrigQuantityDecimal return orig quantity as a decimal, zero if it is empty or malformed
+func (c *CancelOrderResponse) OrigQuantityDecimal() Decimal {
+	return parseDecimalOrZero(c.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return executed quantity as a decimal, zero if it is empty or malformed
+func (c *CancelOrderResponse) ExecutedQuantityDecimal() Decimal {
+	return parseDecimalOrZero(c.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return cummulative quote quantity as a decimal, zero if it is empty or malformed
+func (c *CancelOrderResponse) CummulativeQuoteQuantityDecimal() Decimal {
+	return parseDecimalOrZero(c.CummulativeQuoteQuantity)
+}
+
+// CancelOpenOrdersService cancel all open orders and order lists of a symbol
+type CancelOpenOrdersService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *CancelOpenOrdersService) Symbol(symbol string) *CancelOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *CancelOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOpenOrdersResponse, err error) {
+	r := &request{
+		method:   "DELETE",
+		endpoint: "/api/v3/openOrders",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("symbol", s.symbol)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return
+	}
+	res = new(CancelOpenOrdersResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// CancelOpenOrdersResponse define the orders and order lists canceled by
+// CancelOpenOrdersService. The orders of the canceled order lists are
+// reported in the lists, not in Orders.
+type CancelOpenOrdersResponse struct {
+	Orders     []*CancelOrderResponse
+	OrderLists []*OrderList
+}
+
+// UnmarshalJSON split the mixed array of orders and order lists
+func (c *CancelOpenOrdersResponse) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	c.Orders = make([]*CancelOrderResponse, 0, len(items))
+	c.OrderLists = make([]*OrderList, 0)
+	for _, item := range items {
+		head := struct {
+			ContingencyType string `json:"contingencyType"`
+		}{}
+		if err := json.Unmarshal(item, &head); err != nil {
+			return err
+		}
+		if head.ContingencyType != "" {
+			list := new(OrderList)
+			if err := json.Unmarshal(item, list); err != nil {
+				return err
+			}
+			c.OrderLists = append(c.OrderLists, list)
+			continue
+		}
+		order := new(CancelOrderResponse)
+		if err := json.Unmarshal(item, order); err != nil {
+			return err
+		}
+		c.Orders = append(c.Orders, order)
+	}
+	return nil
 }
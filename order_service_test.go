@@ -60,6 +60,68 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderFull() {
+	data := []byte(`{
+        "symbol": "BTCUSDT",
+        "orderId": 28,
+        "clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+        "transactTime": 1507725176595,
+        "price": "0.00000000",
+        "origQty": "10.00000000",
+        "executedQty": "10.00000000",
+        "cummulativeQuoteQty": "39990.00000000",
+        "status": "FILLED",
+        "timeInForce": "GTC",
+        "type": "MARKET",
+        "side": "SELL",
+        "fills": [
+            {"price": "4000.00000000", "qty": "1.00000000", "commission": "4.00000000", "commissionAsset": "USDT", "tradeId": 56},
+            {"price": "3999.00000000", "qty": "5.00000000", "commission": "19.99500000", "commissionAsset": "USDT", "tradeId": 57},
+            {"price": "3998.00000000", "qty": "2.00000000", "commission": "0.01000000", "commissionAsset": "BNB", "tradeId": 58},
+            {"price": "3997.00000000", "qty": "1.00000000", "commission": "3.99700000", "commissionAsset": "USDT", "tradeId": 59},
+            {"price": "3995.00000000", "qty": "1.00000000", "commission": "3.99500000", "commissionAsset": "USDT", "tradeId": 60}
+        ]
+    }`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeSell,
+			"type":             OrderTypeMarket,
+			"quantity":         "10",
+			"newOrderRespType": NewOrderRespTypeFULL,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeMarket).Quantity("10").NewOrderRespType(NewOrderRespTypeFULL).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("39990.00000000", res.CummulativeQuoteQuantity)
+	r.Len(res.Fills, 5)
+	r.Equal(&Fill{
+		TradeID:         56,
+		Price:           "4000.00000000",
+		Quantity:        "1.00000000",
+		Commission:      "4.00000000",
+		CommissionAsset: "USDT",
+	}, res.Fills[0])
+	// (4000 + 5 * 3999 + 2 * 3998 + 3997 + 3995) / 10
+	r.Equal("3998.3000000000000000", res.AvgPrice().String())
+	fees := res.Fees()
+	r.Len(fees, 2)
+	r.Equal("31.98700000", fees["USDT"].String())
+	r.Equal("0.01000000", fees["BNB"].String())
+
+	// without fills the average price comes from the cummulative quote quantity
+	res.Fills = nil
+	r.True(res.AvgPrice().Equal(MustParseDecimal("3999")))
+	r.Empty(res.Fees())
+	res.ExecutedQuantity = "0"
+	r.True(res.AvgPrice().IsZero())
+}
+
 func (s *orderServiceTestSuite) assertCreateOrderResponseEqual(e, a *CreateOrderResponse) {
 	r := s.r()
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
@@ -283,6 +345,74 @@ func (s *orderServiceTestSuite) TestCancelOrder() {
 	s.assertCancelOrderResponseEqual(e, res)
 }
 
+func (s *orderServiceTestSuite) TestCancelOpenOrders() {
+	data := []byte(`[
+        {
+            "symbol": "BTCUSDT",
+            "origClientOrderId": "E6APeyTJvkMvLMYMqu1KQ4",
+            "orderId": 11,
+            "orderListId": -1,
+            "clientOrderId": "pXLV6Hz6mprAcVYpVMTGgx",
+            "price": "0.089853",
+            "origQty": "0.178622",
+            "executedQty": "0.000000",
+            "cummulativeQuoteQty": "0.000000",
+            "status": "CANCELED",
+            "timeInForce": "GTC",
+            "type": "LIMIT",
+            "side": "BUY"
+        },
+        {
+            "orderListId": 1929,
+            "contingencyType": "OCO",
+            "listStatusType": "ALL_DONE",
+            "listOrderStatus": "ALL_DONE",
+            "listClientOrderId": "2inzWQdDvZLHbbAmAozX2N",
+            "transactionTime": 1585230948299,
+            "symbol": "BTCUSDT",
+            "orders": [
+                {"symbol": "BTCUSDT", "orderId": 20, "clientOrderId": "CwOOIPHSmYywx6jZX77TdL"},
+                {"symbol": "BTCUSDT", "orderId": 21, "clientOrderId": "461cPg51vQjV3zIMOXNz39"}
+            ],
+            "orderReports": [
+                {"symbol": "BTCUSDT", "orderId": 20, "orderListId": 1929, "status": "CANCELED", "type": "STOP_LOSS_LIMIT"},
+                {"symbol": "BTCUSDT", "orderId": 21, "orderListId": 1929, "status": "CANCELED", "type": "LIMIT_MAKER"}
+            ]
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol": "BTCUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCancelOpenOrdersService().Symbol("BTCUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res.Orders, 1)
+	r.Equal(&CancelOrderResponse{
+		Symbol:                   "BTCUSDT",
+		OrigClientOrderID:        "E6APeyTJvkMvLMYMqu1KQ4",
+		OrderID:                  11,
+		OrderListID:              -1,
+		ClientOrderID:            "pXLV6Hz6mprAcVYpVMTGgx",
+		Price:                    "0.089853",
+		OrigQuantity:             "0.178622",
+		ExecutedQuantity:         "0.000000",
+		CummulativeQuoteQuantity: "0.000000",
+		Status:                   "CANCELED",
+		TimeInForce:              "GTC",
+		Type:                     "LIMIT",
+		Side:                     "BUY",
+	}, res.Orders[0])
+	r.Len(res.OrderLists, 1)
+	r.Equal(int64(1929), res.OrderLists[0].OrderListID)
+	r.Len(res.OrderLists[0].OrderReports, 2)
+	r.Equal(OrderStatusTypeCanceled, res.OrderLists[0].OrderReports[1].Status)
+}
+
 func (s *orderServiceTestSuite) assertCancelOrderResponseEqual(e, a *CancelOrderResponse) {
 	r := s.r()
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
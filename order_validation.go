@@ -0,0 +1,197 @@
+package binance
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OrderViolation define an order parameter breaking a rule of its symbol
+type OrderViolation struct {
+	// Filter is the filter breached, empty for rules which are not filters
+	Filter FilterType
+	// Field is the order parameter, like price or quantity
+	Field  string
+	Reason string
+}
+
+// String return the field, filter and reason of the violation
+func (v *OrderViolation) String() string {
+	if v.Filter == "" {
+		return fmt.Sprintf("%s: %s", v.Field, v.Reason)
+	}
+	return fmt.Sprintf("%s: %s (%s)", v.Field, v.Reason, v.Filter)
+}
+
+// OrderValidationError is returned when an order would be rejected by the
+// filters of its symbol
+type OrderValidationError struct {
+	Symbol     string
+	Violations []*OrderViolation
+}
+
+// Error return all the violations
+func (e *OrderValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.String()
+	}
+	return fmt.Sprintf("<OrderValidationError> symbol=%s, %s", e.Symbol, strings.Join(msgs, "; "))
+}
+
+type orderValidator struct {
+	violations []*OrderViolation
+}
+
+func (v *orderValidator) add(filter FilterType, field string, format string, args ...interface{}) {
+	v.violations = append(v.violations, &OrderViolation{
+		Filter: filter,
+		Field:  field,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// decimal parse a mandatory or optional order parameter
+func (v *orderValidator) decimal(field string, value string) (Decimal, bool) {
+	if value == "" {
+		return Decimal{}, false
+	}
+	d, err := ParseDecimal(value)
+	if err != nil {
+		v.add("", field, "invalid number %q", value)
+		return Decimal{}, false
+	}
+	if d.Sign() <= 0 {
+		v.add("", field, "%s must be positive", value)
+		return Decimal{}, false
+	}
+	return d, true
+}
+
+// checkRange check min <= value <= max and (value - min) % step == 0,
+// ignoring the rules set to zero
+func (v *orderValidator) checkRange(filter FilterType, field string, value, min, max, step Decimal, minName, maxName, stepName string) {
+	if !min.IsZero() && value.LessThan(min) {
+		v.add(filter, field, "%s is below %s %s", value, minName, min)
+	}
+	if !max.IsZero() && value.GreaterThan(max) {
+		v.add(filter, field, "%s is above %s %s", value, maxName, max)
+	}
+	if !step.IsZero() && !value.Sub(min).Mod(step).IsZero() {
+		v.add(filter, field, "%s is not a multiple of %s %s", value, stepName, step)
+	}
+}
+
+func (v *orderValidator) checkPrice(field string, price Decimal, f *PriceFilter) {
+	if f == nil {
+		return
+	}
+	v.checkRange(FilterTypePrice, field, price, f.MinPrice, f.MaxPrice, f.TickSize, "minPrice", "maxPrice", "tickSize")
+}
+
+func (v *orderValidator) checkLotSize(field string, quantity Decimal, f *LotSizeFilter) {
+	if f == nil {
+		return
+	}
+	v.checkRange(FilterTypeLotSize, field, quantity, f.MinQuantity, f.MaxQuantity, f.StepSize, "minQty", "maxQty", "stepSize")
+}
+
+func (v *orderValidator) checkMarketLotSize(field string, quantity Decimal, f *MarketLotSizeFilter) {
+	if f == nil {
+		return
+	}
+	v.checkRange(FilterTypeMarketLotSize, field, quantity, f.MinQuantity, f.MaxQuantity, f.StepSize, "minQty", "maxQty", "stepSize")
+}
+
+// orderTypeNeedsPrice check if orders of type t must have a price
+func orderTypeNeedsPrice(t OrderType) bool {
+	switch t {
+	case OrderTypeLimit, OrderTypeStopLossLimit, OrderTypeTakeProfitLimit, OrderTypeLimitMaker:
+		return true
+	}
+	return false
+}
+
+// orderTypeNeedsStopPrice check if orders of type t must have a stop price
+func orderTypeNeedsStopPrice(t OrderType) bool {
+	switch t {
+	case OrderTypeStopLoss, OrderTypeStopLossLimit, OrderTypeTakeProfit, OrderTypeTakeProfitLimit:
+		return true
+	}
+	return false
+}
+
+// Validate check the order against the rules and filters of symbol without
+// sending it. It returns an *OrderValidationError listing every violation.
+func (s *CreateOrderService) Validate(symbol *ExchangeInfoSymbol) error {
+	v := new(orderValidator)
+	if s.symbol != symbol.Symbol {
+		v.add("", "symbol", "order symbol %s does not match %s", s.symbol, symbol.Symbol)
+	}
+	if len(symbol.OrderTypes) > 0 {
+		allowed := false
+		for _, t := range symbol.OrderTypes {
+			allowed = allowed || t == s.orderType
+		}
+		if !allowed {
+			v.add("", "type", "order type %s is not allowed", s.orderType)
+		}
+	}
+
+	quantity, hasQuantity := v.decimal("quantity", s.quantity)
+	if !hasQuantity && s.quantity == "" {
+		v.add("", "quantity", "quantity is mandatory")
+	}
+	if hasQuantity {
+		v.checkLotSize("quantity", quantity, symbol.LotSizeFilter())
+		if s.orderType == OrderTypeMarket {
+			v.checkMarketLotSize("quantity", quantity, symbol.MarketLotSizeFilter())
+		}
+	}
+
+	price, hasPrice := v.decimal("price", s.price)
+	if !hasPrice && s.price == "" && orderTypeNeedsPrice(s.orderType) {
+		v.add("", "price", "price is mandatory for %s orders", s.orderType)
+	}
+	if hasPrice {
+		v.checkPrice("price", price, symbol.PriceFilter())
+	}
+
+	stopPrice := ""
+	if s.stopPrice != nil {
+		stopPrice = *s.stopPrice
+	}
+	if sp, ok := v.decimal("stopPrice", stopPrice); ok {
+		v.checkPrice("stopPrice", sp, symbol.PriceFilter())
+	} else if stopPrice == "" && orderTypeNeedsStopPrice(s.orderType) {
+		v.add("", "stopPrice", "stopPrice is mandatory for %s orders", s.orderType)
+	}
+
+	if f := symbol.MinNotionalFilter(); f != nil && hasQuantity && hasPrice && !f.MinNotional.IsZero() {
+		if notional := price.Mul(quantity); notional.LessThan(f.MinNotional) {
+			v.add(FilterTypeMinNotional, "quantity", "notional %s is below minNotional %s", notional, f.MinNotional)
+		}
+	}
+
+	if s.icebergQuantity != nil {
+		if !symbol.IcebergAllowed {
+			v.add("", "icebergQty", "iceberg orders are not allowed")
+		}
+		if iceberg, ok := v.decimal("icebergQty", *s.icebergQuantity); ok {
+			v.checkLotSize("icebergQty", iceberg, symbol.LotSizeFilter())
+			if f := symbol.IcebergPartsFilter(); f != nil && f.Limit > 0 && hasQuantity {
+				parts := quantity.Div(iceberg, 0)
+				if parts.Mul(iceberg).LessThan(quantity) {
+					parts = parts.Add(NewDecimal(1, 0))
+				}
+				if parts.GreaterThan(NewDecimal(int64(f.Limit), 0)) {
+					v.add(FilterTypeIcebergParts, "icebergQty", "order would be split in %s parts, limit is %d", parts, f.Limit)
+				}
+			}
+		}
+	}
+
+	if len(v.violations) > 0 {
+		return &OrderValidationError{Symbol: s.symbol, Violations: v.violations}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package binance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderValidationTestSuite struct {
+	baseTestSuite
+	symbol *ExchangeInfoSymbol
+}
+
+func TestOrderValidation(t *testing.T) {
+	suite.Run(t, new(orderValidationTestSuite))
+}
+
+func (s *orderValidationTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	res := new(ExchangeInfoResponse)
+	s.r().NoError(json.Unmarshal([]byte(exchangeInfoFiltersJSON), res))
+	s.symbol = res.Symbols[0]
+}
+
+func (s *orderValidationTestSuite) violations(err error) []*OrderViolation {
+	s.r().Error(err)
+	validationErr, ok := err.(*OrderValidationError)
+	s.r().True(ok)
+	return validationErr.Violations
+}
+
+func (s *orderValidationTestSuite) TestValidOrder() {
+	err := s.client.NewCreateOrderService().Symbol("ETHBTC").
+		Side(SideTypeBuy).Type(OrderTypeLimit).TimeInForce(TimeInForceGTC).
+		Quantity("1.5").Price("0.0523").Validate(s.symbol)
+	s.r().NoError(err)
+}
+
+func (s *orderValidationTestSuite) TestPriceAndLotSize() {
+	err := s.client.NewCreateOrderService().Symbol("ETHBTC").
+		Side(SideTypeBuy).Type(OrderTypeLimit).TimeInForce(TimeInForceGTC).
+		Quantity("1000.0005").Price("0.00000150").Validate(s.symbol)
+	v := s.violations(err)
+	s.r().Len(v, 2)
+	s.r().Equal(FilterTypeLotSize, v[0].Filter)
+	s.r().Equal("quantity", v[0].Field)
+	s.r().Equal(FilterTypePrice, v[1].Filter)
+	s.r().Equal("price", v[1].Field)
+	s.r().Equal("ETHBTC", err.(*OrderValidationError).Symbol)
+	s.r().Equal("price: 0.00000150 is not a multiple of tickSize 0.00000100 (PRICE_FILTER)", v[1].String())
+}
+
+func (s *orderValidationTestSuite) TestMinNotional() {
+	err := s.client.NewCreateOrderService().Symbol("ETHBTC").
+		Side(SideTypeBuy).Type(OrderTypeLimit).TimeInForce(TimeInForceGTC).
+		Quantity("0.001").Price("0.5").Validate(s.symbol)
+	v := s.violations(err)
+	s.r().Len(v, 1)
+	s.r().Equal(FilterTypeMinNotional, v[0].Filter)
+}
+
+func (s *orderValidationTestSuite) TestMarketLotSize() {
+	err := s.client.NewCreateOrderService().Symbol("ETHBTC").
+		Side(SideTypeSell).Type(OrderTypeMarket).Quantity("5000").Validate(s.symbol)
+	v := s.violations(err)
+	s.r().Len(v, 1)
+	s.r().Equal(FilterTypeMarketLotSize, v[0].Filter)
+}
+
+func (s *orderValidationTestSuite) TestMissingFieldsAndType() {
+	err := s.client.NewCreateOrderService().Symbol("BNBBTC").
+		Side(SideTypeBuy).Type(OrderTypeStopLossLimit).Quantity("abc").Validate(s.symbol)
+	v := s.violations(err)
+	fields := make([]string, len(v))
+	for i, violation := range v {
+		fields[i] = violation.Field
+	}
+	s.r().Equal([]string{"symbol", "type", "quantity", "price", "stopPrice"}, fields)
+}
+
+func (s *orderValidationTestSuite) TestIcebergParts() {
+	err := s.client.NewCreateOrderService().Symbol("ETHBTC").
+		Side(SideTypeBuy).Type(OrderTypeLimit).TimeInForce(TimeInForceGTC).
+		Quantity("10").Price("0.05").IcebergQuantity("0.9").Validate(s.symbol)
+	v := s.violations(err)
+	s.r().Len(v, 1)
+	s.r().Equal(FilterTypeIcebergParts, v[0].Filter)
+	s.r().Contains(v[0].Reason, "12 parts")
+
+	s.symbol.IcebergAllowed = false
+	err = s.client.NewCreateOrderService().Symbol("ETHBTC").
+		Side(SideTypeBuy).Type(OrderTypeLimit).TimeInForce(TimeInForceGTC).
+		Quantity("10").Price("0.05").IcebergQuantity("1").Validate(s.symbol)
+	v = s.violations(err)
+	s.r().Len(v, 1)
+	s.r().Equal("icebergQty", v[0].Field)
+}
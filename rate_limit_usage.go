@@ -0,0 +1,179 @@
+package binance
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	usedWeightHeaderPrefix = "X-Mbx-Used-Weight"
+	orderCountHeaderPrefix = "X-Mbx-Order-Count"
+)
+
+// RateLimitUsage define request weight and order counts reported by the server
+// in the X-MBX-USED-WEIGHT-* and X-MBX-ORDER-COUNT-* response headers.
+// Maps are keyed by interval in the header form, like "1M" or "10S".
+type RateLimitUsage struct {
+	UsedWeight map[string]int
+	OrderCount map[string]int
+	UpdateTime time.Time
+}
+
+// RateLimitUsageHandler handle rate limit usage reported by a response
+type RateLimitUsageHandler func(usage RateLimitUsage)
+
+// rateLimitUsageTracker keep the latest usage reported by the server
+type rateLimitUsageTracker struct {
+	mu    sync.Mutex
+	usage RateLimitUsage
+}
+
+// update merge the usage found in header, ok is false if header has no usage
+func (t *rateLimitUsageTracker) update(header http.Header, now time.Time) (usage RateLimitUsage, ok bool) {
+	weight := parseUsageHeaders(header, usedWeightHeaderPrefix)
+	orders := parseUsageHeaders(header, orderCountHeaderPrefix)
+	if len(weight) == 0 && len(orders) == 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(weight) > 0 {
+		t.usage.UsedWeight = weight
+	}
+	if len(orders) > 0 {
+		// order counts are only returned by order endpoints, keep the others
+		merged := make(map[string]int, len(t.usage.OrderCount)+len(orders))
+		for k, v := range t.usage.OrderCount {
+			merged[k] = v
+		}
+		for k, v := range orders {
+			merged[k] = v
+		}
+		t.usage.OrderCount = merged
+	}
+	t.usage.UpdateTime = now
+	return t.usage.copy(), true
+}
+
+func (t *rateLimitUsageTracker) get() RateLimitUsage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.usage.copy()
+}
+
+func (u RateLimitUsage) copy() RateLimitUsage {
+	c := RateLimitUsage{UpdateTime: u.UpdateTime}
+	if u.UsedWeight != nil {
+		c.UsedWeight = make(map[string]int, len(u.UsedWeight))
+		for k, v := range u.UsedWeight {
+			c.UsedWeight[k] = v
+		}
+	}
+	if u.OrderCount != nil {
+		c.OrderCount = make(map[string]int, len(u.OrderCount))
+		for k, v := range u.OrderCount {
+			c.OrderCount[k] = v
+		}
+	}
+	return c
+}
+
+// parseUsageHeaders collect headers like X-MBX-USED-WEIGHT-1M into a map keyed by interval.
+// The legacy X-MBX-USED-WEIGHT header without interval is reported as 1M.
+func parseUsageHeaders(header http.Header, prefix string) map[string]int {
+	m := make(map[string]int)
+	for k, v := range header {
+		k = http.CanonicalHeaderKey(k)
+		if len(v) == 0 || !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(v[0]))
+		if err != nil {
+			continue
+		}
+		interval := strings.ToUpper(strings.TrimPrefix(strings.TrimPrefix(k, prefix), "-"))
+		if interval == "" {
+			if _, ok := m["1M"]; ok {
+				continue
+			}
+			interval = "1M"
+		}
+		m[interval] = n
+	}
+	return m
+}
+
+// parseUsageInterval convert an interval like 1M or 10S to a duration
+func parseUsageInterval(interval string) (time.Duration, bool) {
+	if len(interval) < 2 {
+		return 0, false
+	}
+	num, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil {
+		return 0, false
+	}
+	var unit string
+	switch interval[len(interval)-1] {
+	case 'S':
+		unit = "SECOND"
+	case 'M':
+		unit = "MINUTE"
+	case 'H':
+		unit = "HOUR"
+	case 'D':
+		unit = "DAY"
+	default:
+		return 0, false
+	}
+	return rateLimitInterval(unit, num), true
+}
+
+// RateLimitUsage return the latest rate limit usage reported by the server
+func (c *Client) RateLimitUsage() RateLimitUsage {
+	return c.usage.get()
+}
+
+// recordUsage track usage headers of a response and propagate them to the
+// rate limiter and the usage handler
+func (c *Client) recordUsage(header http.Header) {
+	usage, ok := c.usage.update(header, time.Now())
+	if !ok {
+		return
+	}
+	c.RateLimiter.syncUsage(usage)
+	if c.RateLimitUsageHandler != nil {
+		c.RateLimitUsageHandler(usage)
+	}
+}
+
+// syncUsage raise local counters to the usage accounted by the server
+func (l *RateLimiter) syncUsage(usage RateLimitUsage) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	apply := func(limitType RateLimitType, used map[string]int) {
+		for interval, n := range used {
+			d, ok := parseUsageInterval(interval)
+			if !ok {
+				continue
+			}
+			for _, w := range l.windows {
+				if w.limitType != limitType || w.interval != d {
+					continue
+				}
+				w.roll(now)
+				if n > w.used {
+					w.used = n
+				}
+			}
+		}
+	}
+	apply(RateLimitTypeRequestWeight, usage.UsedWeight)
+	apply(RateLimitTypeOrders, usage.OrderCount)
+}
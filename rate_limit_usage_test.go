@@ -0,0 +1,119 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type rateLimitUsageTestSuite struct {
+	baseTestSuite
+}
+
+func TestRateLimitUsage(t *testing.T) {
+	suite.Run(t, new(rateLimitUsageTestSuite))
+}
+
+func (s *rateLimitUsageTestSuite) mockDoWithHeader(data []byte, statusCode int, header http.Header) {
+	s.client.Client.do = s.client.do
+	res := newHTTPResponse(data, statusCode)
+	res.Header = header
+	s.client.On("do", anyHTTPRequest()).Return(res, nil).Once()
+}
+
+func (s *rateLimitUsageTestSuite) TestParseHeaders() {
+	s.mockDoWithHeader([]byte(`{}`), http.StatusOK, http.Header{
+		"X-Mbx-Used-Weight":      []string{"10"},
+		"X-Mbx-Used-Weight-1m":   []string{"12"},
+		"X-Mbx-Order-Count-10s":  []string{"2"},
+		"X-Mbx-Order-Count-1d":   []string{"30"},
+		"Content-Type":           []string{"application/json"},
+		"X-Mbx-Used-Weight-Oops": []string{"x"},
+	})
+	var handled []RateLimitUsage
+	s.client.RateLimitUsageHandler = func(usage RateLimitUsage) {
+		handled = append(handled, usage)
+	}
+
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	usage := s.client.RateLimitUsage()
+	r.Equal(map[string]int{"1M": 12}, usage.UsedWeight)
+	r.Equal(map[string]int{"10S": 2, "1D": 30}, usage.OrderCount)
+	r.False(usage.UpdateTime.IsZero())
+	r.Len(handled, 1)
+	r.Equal(usage, handled[0])
+}
+
+func (s *rateLimitUsageTestSuite) TestKeepOrderCount() {
+	s.mockDoWithHeader([]byte(`{}`), http.StatusOK, http.Header{
+		"X-Mbx-Used-Weight-1m":  []string{"1"},
+		"X-Mbx-Order-Count-10s": []string{"1"},
+	})
+	s.mockDoWithHeader([]byte(`{}`), http.StatusBadRequest, http.Header{
+		"X-Mbx-Used-Weight-1m": []string{"6"},
+	})
+
+	r := s.r()
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	r.Error(err)
+	usage := s.client.RateLimitUsage()
+	r.Equal(map[string]int{"1M": 6}, usage.UsedWeight)
+	r.Equal(map[string]int{"10S": 1}, usage.OrderCount)
+}
+
+func (s *rateLimitUsageTestSuite) TestNoHeaders() {
+	s.mockDo([]byte(`{}`), nil)
+	called := false
+	s.client.RateLimitUsageHandler = func(usage RateLimitUsage) {
+		called = true
+	}
+
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.False(called)
+	r.Nil(s.client.RateLimitUsage().UsedWeight)
+}
+
+func (s *rateLimitUsageTestSuite) TestSyncRateLimiter() {
+	now := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(RateLimitPolicyFailFast, &ExchangeInfoRateLimit{
+		RateLimitType: "REQUEST_WEIGHT",
+		Interval:      "MINUTE",
+		IntervalNum:   1,
+		Limit:         20,
+	})
+	l.now = func() time.Time {
+		return now
+	}
+	s.client.RateLimiter = l
+	s.mockDoWithHeader([]byte(`{}`), http.StatusOK, http.Header{
+		"X-Mbx-Used-Weight-1m": []string{"18"},
+	})
+
+	r := s.r()
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	r.Equal(ErrRateLimitExceeded, err)
+}
+
+func (s *rateLimitUsageTestSuite) TestParseUsageInterval() {
+	r := s.r()
+	d, ok := parseUsageInterval("10S")
+	r.True(ok)
+	r.Equal(10*time.Second, d)
+	d, ok = parseUsageInterval("1D")
+	r.True(ok)
+	r.Equal(24*time.Hour, d)
+	_, ok = parseUsageInterval("M")
+	r.False(ok)
+	_, ok = parseUsageInterval("1W")
+	r.False(ok)
+}
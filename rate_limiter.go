@@ -0,0 +1,259 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitType define rate limit type
+type RateLimitType string
+
+// RateLimitPolicy define what the rate limiter does when a limit is reached
+type RateLimitPolicy int
+
+// Rate limit types
+const (
+	RateLimitTypeRequestWeight RateLimitType = "REQUEST_WEIGHT"
+	RateLimitTypeOrders        RateLimitType = "ORDERS"
+	RateLimitTypeRawRequests   RateLimitType = "RAW_REQUESTS"
+)
+
+// Rate limit policies
+const (
+	// RateLimitPolicyWait block the caller until the request fits in every window
+	RateLimitPolicyWait RateLimitPolicy = iota
+	// RateLimitPolicyFailFast return ErrRateLimitExceeded instead of blocking
+	RateLimitPolicyFailFast
+)
+
+// ErrRateLimitExceeded is returned by a fail fast rate limiter when sending the
+// request would exceed one of the limits
+var ErrRateLimitExceeded = errors.New("binance: client side rate limit exceeded")
+
+// DefaultRateLimits are the documented limits used until the rate limiter is
+// seeded from an ExchangeInfoResponse
+var DefaultRateLimits = []*ExchangeInfoRateLimit{
+	{RateLimitType: string(RateLimitTypeRequestWeight), Interval: "MINUTE", IntervalNum: 1, Limit: 1200},
+	{RateLimitType: string(RateLimitTypeOrders), Interval: "SECOND", IntervalNum: 1, Limit: 10},
+	{RateLimitType: string(RateLimitTypeOrders), Interval: "DAY", IntervalNum: 1, Limit: 100000},
+	{RateLimitType: string(RateLimitTypeRawRequests), Interval: "MINUTE", IntervalNum: 5, Limit: 5000},
+}
+
+// rateLimitWindow count usage of a single limit in fixed windows aligned on the interval
+type rateLimitWindow struct {
+	limitType RateLimitType
+	interval  time.Duration
+	limit     int
+	start     time.Time
+	used      int
+}
+
+// RateLimiter throttle requests sent by a Client according to the exchange rate limits.
+// It is safe for concurrent use.
+type RateLimiter struct {
+	mu      sync.Mutex
+	policy  RateLimitPolicy
+	windows []*rateLimitWindow
+	now     func() time.Time
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// NewRateLimiter init a rate limiter, DefaultRateLimits are used if no limits are given
+func NewRateLimiter(policy RateLimitPolicy, limits ...*ExchangeInfoRateLimit) *RateLimiter {
+	l := &RateLimiter{
+		policy: policy,
+		now:    time.Now,
+		sleep:  sleepContext,
+	}
+	if len(limits) == 0 {
+		limits = DefaultRateLimits
+	}
+	l.SetLimits(limits)
+	return l
+}
+
+// SetLimits replace the limits enforced by the rate limiter, usually with
+// ExchangeInfoResponse.RateLimits. Usage of unchanged limits is kept.
+func (l *RateLimiter) SetLimits(limits []*ExchangeInfoRateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	windows := make([]*rateLimitWindow, 0, len(limits))
+	for _, limit := range limits {
+		interval := rateLimitInterval(limit.Interval, limit.IntervalNum)
+		if interval <= 0 || limit.Limit <= 0 {
+			continue
+		}
+		w := &rateLimitWindow{
+			limitType: RateLimitType(limit.RateLimitType),
+			interval:  interval,
+			limit:     limit.Limit,
+		}
+		for _, old := range l.windows {
+			if old.limitType == w.limitType && old.interval == w.interval {
+				w.start, w.used = old.start, old.used
+			}
+		}
+		windows = append(windows, w)
+	}
+	l.windows = windows
+}
+
+// Wait reserve weight and orders in every window, blocking until they fit or
+// returning ErrRateLimitExceeded depending on the policy
+func (l *RateLimiter) Wait(ctx context.Context, weight int, orders int) error {
+	for {
+		l.mu.Lock()
+		wait, err := l.reserve(weight, orders)
+		l.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if wait <= 0 {
+			return nil
+		}
+		if err = l.sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve consume the cost if it fits in every window, otherwise return how
+// long to wait before trying again
+func (l *RateLimiter) reserve(weight int, orders int) (wait time.Duration, err error) {
+	now := l.now()
+	for _, w := range l.windows {
+		cost := w.cost(weight, orders)
+		if cost == 0 {
+			continue
+		}
+		if cost > w.limit {
+			return 0, ErrRateLimitExceeded
+		}
+		w.roll(now)
+		if w.used+cost > w.limit {
+			if l.policy == RateLimitPolicyFailFast {
+				return 0, ErrRateLimitExceeded
+			}
+			if d := w.start.Add(w.interval).Sub(now); d > wait {
+				wait = d
+			}
+		}
+	}
+	if wait > 0 {
+		return wait, nil
+	}
+	for _, w := range l.windows {
+		w.used += w.cost(weight, orders)
+	}
+	return 0, nil
+}
+
+func (w *rateLimitWindow) roll(now time.Time) {
+	start := now.Truncate(w.interval)
+	if !start.Equal(w.start) {
+		w.start = start
+		w.used = 0
+	}
+}
+
+func (w *rateLimitWindow) cost(weight int, orders int) int {
+	switch w.limitType {
+	case RateLimitTypeRequestWeight:
+		return weight
+	case RateLimitTypeOrders:
+		return orders
+	case RateLimitTypeRawRequests:
+		return 1
+	}
+	return 0
+}
+
+func rateLimitInterval(interval string, num int) time.Duration {
+	if num <= 0 {
+		num = 1
+	}
+	var d time.Duration
+	switch strings.ToUpper(interval) {
+	case "SECOND":
+		d = time.Second
+	case "MINUTE":
+		d = time.Minute
+	case "HOUR":
+		d = time.Hour
+	case "DAY":
+		d = 24 * time.Hour
+	}
+	return d * time.Duration(num)
+}
+
+// wait reserve the weight of r, a nil rate limiter never blocks
+func (l *RateLimiter) wait(ctx context.Context, r *request) error {
+	if l == nil {
+		return nil
+	}
+	return l.Wait(ctx, requestWeight(r), requestOrders(r))
+}
+
+// endpointWeights define the request weight of endpoints without parameter dependent weight
+var endpointWeights = map[string]int{
+	"/api/v3/allOrders":        5,
+	"/api/v3/account":          5,
+	"/api/v3/myTrades":         5,
+	"/api/v1/historicalTrades": 5,
+	"/api/v3/orderList":        2,
+	"/api/v3/allOrderList":     10,
+	"/api/v3/openOrderList":    3,
+}
+
+// requestWeight return the weight of a request, 1 unless documented otherwise
+func requestWeight(r *request) int {
+	switch r.endpoint {
+	case "/api/v1/depth":
+		return depthWeight(r.query.Get("limit"))
+	case "/api/v1/ticker/24hr", "/api/v3/openOrders":
+		// canceling open orders always targets a single symbol
+		if r.method != "DELETE" && r.query.Get("symbol") == "" {
+			return 40
+		}
+	case "/api/v3/ticker/bookTicker":
+		if r.query.Get("symbol") == "" {
+			return 2
+		}
+	case "/api/v1/ticker/allPrices":
+		// ListPricesService always returns every symbol
+		return 2
+	}
+	if w, ok := endpointWeights[r.endpoint]; ok {
+		return w
+	}
+	return 1
+}
+
+func depthWeight(limit string) int {
+	switch limit {
+	case "", "5", "10", "20", "50", "100":
+		return 1
+	case "500":
+		return 5
+	case "1000":
+		return 10
+	}
+	return 50
+}
+
+// requestOrders return the number of orders a request places
+func requestOrders(r *request) int {
+	if r.method != "POST" {
+		return 0
+	}
+	switch r.endpoint {
+	case "/api/v3/order", "/api/v3/order/cancelReplace":
+		return 1
+	case "/api/v3/order/oco":
+		return 2
+	}
+	return 0
+}
@@ -0,0 +1,244 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type rateLimiterTestSuite struct {
+	baseTestSuite
+	now   time.Time
+	slept []time.Duration
+}
+
+func TestRateLimiter(t *testing.T) {
+	suite.Run(t, new(rateLimiterTestSuite))
+}
+
+func (s *rateLimiterTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.now = time.Date(2018, 1, 1, 0, 0, 30, 0, time.UTC)
+	s.slept = nil
+}
+
+func (s *rateLimiterTestSuite) newRateLimiter(policy RateLimitPolicy, limits ...*ExchangeInfoRateLimit) *RateLimiter {
+	l := NewRateLimiter(policy, limits...)
+	l.now = func() time.Time {
+		return s.now
+	}
+	l.sleep = func(ctx context.Context, d time.Duration) error {
+		s.slept = append(s.slept, d)
+		s.now = s.now.Add(d)
+		return nil
+	}
+	return l
+}
+
+func (s *rateLimiterTestSuite) TestWait() {
+	l := s.newRateLimiter(RateLimitPolicyWait, &ExchangeInfoRateLimit{
+		RateLimitType: "REQUEST_WEIGHT",
+		Interval:      "MINUTE",
+		Limit:         10,
+	})
+	r := s.r()
+	r.NoError(l.Wait(newContext(), 6, 0))
+	r.NoError(l.Wait(newContext(), 4, 0))
+	r.Empty(s.slept)
+
+	r.NoError(l.Wait(newContext(), 1, 0))
+	r.Equal([]time.Duration{30 * time.Second}, s.slept)
+}
+
+func (s *rateLimiterTestSuite) TestFailFast() {
+	l := s.newRateLimiter(RateLimitPolicyFailFast, &ExchangeInfoRateLimit{
+		RateLimitType: "ORDERS",
+		Interval:      "SECOND",
+		IntervalNum:   10,
+		Limit:         2,
+	})
+	r := s.r()
+	r.NoError(l.Wait(newContext(), 1, 1))
+	r.NoError(l.Wait(newContext(), 1, 1))
+	r.Equal(ErrRateLimitExceeded, l.Wait(newContext(), 1, 1))
+	r.NoError(l.Wait(newContext(), 1, 0))
+
+	s.now = s.now.Add(10 * time.Second)
+	r.NoError(l.Wait(newContext(), 1, 1))
+}
+
+func (s *rateLimiterTestSuite) TestWeightAboveLimit() {
+	l := s.newRateLimiter(RateLimitPolicyWait, &ExchangeInfoRateLimit{
+		RateLimitType: "REQUEST_WEIGHT",
+		Interval:      "MINUTE",
+		Limit:         10,
+	})
+	s.r().Equal(ErrRateLimitExceeded, l.Wait(newContext(), 11, 0))
+}
+
+func (s *rateLimiterTestSuite) TestSetLimitsKeepsUsage() {
+	l := s.newRateLimiter(RateLimitPolicyFailFast)
+	r := s.r()
+	r.NoError(l.Wait(newContext(), 1000, 0))
+	l.SetLimits([]*ExchangeInfoRateLimit{
+		{RateLimitType: "REQUEST_WEIGHT", Interval: "MINUTE", IntervalNum: 1, Limit: 1100},
+	})
+	r.NoError(l.Wait(newContext(), 100, 0))
+	r.Equal(ErrRateLimitExceeded, l.Wait(newContext(), 1, 0))
+}
+
+func (s *rateLimiterTestSuite) TestConcurrentWait() {
+	l := NewRateLimiter(RateLimitPolicyFailFast, &ExchangeInfoRateLimit{
+		RateLimitType: "RAW_REQUESTS",
+		Interval:      "DAY",
+		Limit:         50,
+	})
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	passed := 0
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if l.Wait(newContext(), 1, 0) == nil {
+				mu.Lock()
+				passed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	s.r().Equal(50, passed)
+}
+
+func (s *rateLimiterTestSuite) TestRequestWeight() {
+	r := s.r()
+	depth := &request{endpoint: "/api/v1/depth"}
+	depth.setParam("limit", 1000)
+	r.Equal(10, requestWeight(depth))
+	r.Equal(1, requestWeight(newRequest().setParam("symbol", "LTCBTC")))
+
+	stats := newRequest()
+	stats.endpoint = "/api/v1/ticker/24hr"
+	r.Equal(40, requestWeight(stats))
+	stats.setParam("symbol", "LTCBTC")
+	r.Equal(1, requestWeight(stats))
+
+	prices := &request{endpoint: "/api/v1/ticker/allPrices"}
+	r.Equal(2, requestWeight(prices))
+
+	account := newRequest()
+	account.endpoint = "/api/v3/account"
+	r.Equal(5, requestWeight(account))
+
+	order := &request{method: "POST", endpoint: "/api/v3/order"}
+	r.Equal(1, requestOrders(order))
+	order.endpoint = "/api/v3/order/test"
+	r.Equal(0, requestOrders(order))
+	order.endpoint = "/api/v3/order/oco"
+	r.Equal(2, requestOrders(order))
+}
+
+func (s *rateLimiterTestSuite) TestClientRateLimiter() {
+	s.client.RateLimiter = s.newRateLimiter(RateLimitPolicyFailFast, &ExchangeInfoRateLimit{
+		RateLimitType: "REQUEST_WEIGHT",
+		Interval:      "MINUTE",
+		Limit:         5,
+	})
+	s.mockDo([]byte(`{}`), nil, http.StatusOK)
+
+	r := s.r()
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	r.Equal(ErrRateLimitExceeded, err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *rateLimiterTestSuite) TestClientWaitBeforeStamp() {
+	l := s.newRateLimiter(RateLimitPolicyWait, &ExchangeInfoRateLimit{
+		RateLimitType: "REQUEST_WEIGHT",
+		Interval:      "MINUTE",
+		Limit:         5,
+	})
+	var waited time.Time
+	l.sleep = func(ctx context.Context, d time.Duration) error {
+		time.Sleep(10 * time.Millisecond)
+		waited = time.Now()
+		s.now = s.now.Add(d)
+		return nil
+	}
+	s.client.RateLimiter = l
+	s.mockDoOnce([]byte(`{}`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`{}`), nil, http.StatusOK)
+	var timestamps []int64
+	s.assertReq(func(r *request) {
+		ts, _ := strconv.ParseInt(r.query.Get(timestampKey), 10, 64)
+		timestamps = append(timestamps, ts)
+	})
+
+	r := s.r()
+	for i := 0; i < 2; i++ {
+		_, err := s.client.NewGetAccountService().Do(newContext())
+		r.NoError(err)
+	}
+	r.Len(timestamps, 2)
+	r.True(timestamps[1] >= TimeToMillis(waited))
+}
+
+func (s *rateLimiterTestSuite) TestClientCooldownWhileWaiting() {
+	l := s.newRateLimiter(RateLimitPolicyWait, &ExchangeInfoRateLimit{
+		RateLimitType: "REQUEST_WEIGHT",
+		Interval:      "MINUTE",
+		Limit:         5,
+	})
+	l.sleep = func(ctx context.Context, d time.Duration) error {
+		s.client.cooldown.extend(http.StatusTooManyRequests, time.Now().Add(time.Minute))
+		s.now = s.now.Add(d)
+		return nil
+	}
+	s.client.RateLimiter = l
+	s.mockDo([]byte(`{}`), nil, http.StatusOK)
+
+	r := s.r()
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	_, ok := err.(*RateLimitedError)
+	r.True(ok, "%v", err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *rateLimiterTestSuite) TestSeedFromExchangeInfo() {
+	s.client.RateLimiter = s.newRateLimiter(RateLimitPolicyFailFast)
+	data := []byte(`{
+        "timezone": "UTC",
+        "serverTime": 1508631584636,
+        "rateLimits": [
+            {
+                "rateLimitType": "REQUEST_WEIGHT",
+                "interval": "MINUTE",
+                "intervalNum": 1,
+                "limit": 2
+            }
+        ],
+        "symbols": []
+    }`)
+	s.mockDoOnce(data, nil, http.StatusOK)
+	s.mockDoOnce([]byte(`{"serverTime": 1499827319559}`), nil, http.StatusOK)
+
+	r := s.r()
+	res, err := s.client.NewExchangeInfoService().Do(newContext())
+	r.NoError(err)
+	r.Len(res.RateLimits, 1)
+	r.Equal(1, res.RateLimits[0].IntervalNum)
+	_, err = s.client.NewServerTimeService().Do(newContext())
+	r.NoError(err)
+	_, err = s.client.NewServerTimeService().Do(newContext())
+	r.Equal(ErrRateLimitExceeded, err)
+}
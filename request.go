@@ -28,6 +28,8 @@ type request struct {
 	header     http.Header
 	body       io.Reader
 	fullURL    string
+	// rateLimiter replaces the rate limiter of the client for the request
+	rateLimiter *RateLimiter
 }
 
 // setParam set param with key/value to query string
@@ -64,6 +66,21 @@ func (r *request) setFormParams(m params) *request {
 	return r
 }
 
+// idempotent check if the request can be sent more than once without side effects.
+// Signed POST requests create resources, so they are only safe to resend when
+// a client order ID lets the server reject duplicates.
+func (r *request) idempotent() bool {
+	if r.method != "POST" || r.secType != secTypeSigned {
+		return true
+	}
+	for _, key := range []string{"newClientOrderId", "listClientOrderId"} {
+		if r.query.Get(key) != "" || r.form.Get(key) != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *request) validate() (err error) {
 	if r.query == nil {
 		r.query = url.Values{}
@@ -83,3 +100,10 @@ func WithRecvWindow(recvWindow int64) RequestOption {
 		r.recvWindow = recvWindow
 	}
 }
+
+// WithRateLimiter throttle the request with l instead of the rate limiter of the client
+func WithRateLimiter(l *RateLimiter) RequestOption {
+	return func(r *request) {
+		r.rateLimiter = l
+	}
+}
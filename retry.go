@@ -0,0 +1,123 @@
+package binance
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryableFunc decide whether a failed attempt should be retried.
+// statusCode is 0 when the request failed before a response was received,
+// in which case err is the transport error; otherwise err is an *APIError,
+// or a *RateLimitedError for 429 and 418 responses.
+type RetryableFunc func(statusCode int, err error) bool
+
+// RetryPolicy define how failed API calls are retried by the client
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one
+	MaxAttempts int
+	// BaseDelay is the delay before the second attempt, doubled on every retry
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts
+	MaxDelay time.Duration
+	// Jitter is the fraction (0..1) of each delay that is randomized
+	Jitter float64
+	// Retryable decides if an error is worth retrying, DefaultRetryable is used if nil
+	Retryable RetryableFunc
+}
+
+// DefaultRetryPolicy return a retry policy with sane defaults
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+		Jitter:      0.5,
+		Retryable:   DefaultRetryable,
+	}
+}
+
+// retrySafeCodes are API error codes returned with a 4xx status which
+// guarantee the request has not been processed by the matching engine
+var retrySafeCodes = map[int64]bool{
+	ErrCodeDisconnected:        true,
+	ErrCodeTooManyRequests:     true,
+	ErrCodeUnexpectedResponse:  true,
+	ErrCodeTimeout:             true,
+	ErrCodeTooManyOrders:       true,
+	ErrCodeServiceShuttingDown: true,
+}
+
+// DefaultRetryable retry transport errors, 5xx responses and 4xx responses
+// carrying a retry-safe API error code. 429 and 418 responses are never
+// retried since they start a client wide cooldown.
+func DefaultRetryable(statusCode int, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case isRateLimitedStatus(statusCode):
+		return false
+	case statusCode == 0:
+		return true
+	case statusCode >= http.StatusInternalServerError:
+		return true
+	}
+	if apiErr, ok := asAPIError(err); ok {
+		return retrySafeCodes[apiErr.Code]
+	}
+	return false
+}
+
+// delay return the backoff delay to wait after the given failed attempt
+func (p *RetryPolicy) delay(attempt int) time.Duration {
+	return backoffDelay(attempt, p.BaseDelay, p.MaxDelay, p.Jitter)
+}
+
+// backoffDelay return base doubled for every attempt after the first one,
+// capped at max and reduced by a random fraction of jitter
+func backoffDelay(attempt int, base, max time.Duration, jitter float64) time.Duration {
+	d := base
+	for i := 1; i < attempt && (max <= 0 || d < max); i++ {
+		d *= 2
+	}
+	if max > 0 && d > max {
+		d = max
+	}
+	if jitter > 0 {
+		if jitter > 1 {
+			jitter = 1
+		}
+		d -= time.Duration(rand.Float64() * jitter * float64(d))
+	}
+	return d
+}
+
+// shouldRetry check if the request may be sent again after the given attempt
+func (p *RetryPolicy) shouldRetry(r *request, attempt int, statusCode int, err error) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if !r.idempotent() {
+		return false
+	}
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = DefaultRetryable
+	}
+	return retryable(statusCode, err)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type retryTestSuite struct {
+	baseTestSuite
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(retryTestSuite))
+}
+
+func (s *retryTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.client.Client.do = s.client.do
+	s.client.RetryPolicy = &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    time.Millisecond,
+	}
+}
+
+func (s *retryTestSuite) TestRetryServerError() {
+	s.mockDoOnce([]byte(`{"code":-1000,"msg":"unknown"}`), nil, http.StatusInternalServerError)
+	s.mockDoOnce(nil, fmt.Errorf("connection reset"), http.StatusOK)
+	s.mockDoOnce([]byte(`{"serverTime": 1499827319559}`), nil, http.StatusOK)
+
+	serverTime, err := s.client.NewServerTimeService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.EqualValues(1499827319559, serverTime)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *retryTestSuite) TestRetryResignsRequest() {
+	s.mockDoOnce([]byte(`{"code":-1007,"msg":"timeout"}`), nil, http.StatusBadRequest)
+	s.mockDoOnce([]byte(`{"code":-1007,"msg":"timeout"}`), nil, http.StatusBadRequest)
+	s.mockDoOnce([]byte(`{}`), nil, http.StatusOK)
+
+	var signatures []string
+	s.assertReq(func(r *request) {
+		s.r().NotEmpty(r.query.Get(timestampKey))
+		signatures = append(signatures, r.query.Get(signatureKey))
+		time.Sleep(2 * time.Millisecond)
+	})
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(signatures, 3)
+	r.NotEqual(signatures[0], signatures[1])
+	r.NotEqual(signatures[1], signatures[2])
+}
+
+func (s *retryTestSuite) TestNoRetryOnClientError() {
+	s.mockDoOnce([]byte(`{"code":-1102,"msg":"mandatory parameter missing"}`), nil, http.StatusBadRequest)
+
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.EqualValues(-1102, err.(*APIError).Code)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *retryTestSuite) TestRetryGivesUp() {
+	for i := 0; i < 3; i++ {
+		s.mockDoOnce([]byte(`{"code":-1001,"msg":"disconnected"}`), nil, http.StatusServiceUnavailable)
+	}
+
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.EqualValues(-1001, err.(*APIError).Code)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *retryTestSuite) TestNoRetryCreateOrderWithoutClientOrderID() {
+	s.mockDoOnce([]byte(`{"code":-1000,"msg":"unknown"}`), nil, http.StatusInternalServerError)
+
+	_, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	s.r().Error(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *retryTestSuite) TestRetryCreateOrderWithClientOrderID() {
+	s.mockDoOnce([]byte(`{"code":-1000,"msg":"unknown"}`), nil, http.StatusInternalServerError)
+	s.mockDoOnce([]byte(`{"symbol":"LTCBTC","orderId":1,"clientOrderId":"myOrder1"}`), nil, http.StatusOK)
+
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").NewClientOrderID("myOrder1").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("myOrder1", res.ClientOrderID)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *retryTestSuite) TestRetryPolicyDelay() {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	r := s.r()
+	r.Equal(100*time.Millisecond, p.delay(1))
+	r.Equal(200*time.Millisecond, p.delay(2))
+	r.Equal(400*time.Millisecond, p.delay(3))
+	r.Equal(time.Second, p.delay(10))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.delay(2)
+		r.True(d > 100*time.Millisecond && d <= 200*time.Millisecond, d)
+	}
+}
+
+func (s *retryTestSuite) TestDefaultRetryable() {
+	r := s.r()
+	r.True(DefaultRetryable(0, fmt.Errorf("dial tcp: timeout")))
+	r.True(DefaultRetryable(http.StatusBadGateway, &APIError{Code: -1000}))
+	r.False(DefaultRetryable(http.StatusTooManyRequests, &RateLimitedError{StatusCode: http.StatusTooManyRequests}))
+	r.True(DefaultRetryable(http.StatusBadRequest, &APIError{Code: -1007}))
+	r.False(DefaultRetryable(http.StatusBadRequest, &APIError{Code: -1013}))
+	r.False(DefaultRetryable(http.StatusUnauthorized, &APIError{Code: -2015}))
+	r.False(DefaultRetryable(http.StatusOK, nil))
+}
@@ -0,0 +1,117 @@
+package binance
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// Signer sign the payload of signed requests, the returned signature is sent
+// as the signature parameter
+type Signer interface {
+	Sign(payload []byte) (signature string, err error)
+}
+
+// SignerFunc adapt a function to the Signer interface, useful to delegate
+// signing to an external process holding the private key
+type SignerFunc func(payload []byte) (string, error)
+
+// Sign call f(payload)
+func (f SignerFunc) Sign(payload []byte) (string, error) {
+	return f(payload)
+}
+
+// hmacSigner sign payloads with HMAC-SHA256, hex encoded
+type hmacSigner struct {
+	key []byte
+}
+
+// NewHMACSigner init a signer using HMAC-SHA256 of a secret key
+func NewHMACSigner(secretKey string) Signer {
+	return &hmacSigner{key: []byte(secretKey)}
+}
+
+func (s *hmacSigner) Sign(payload []byte) (string, error) {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(payload)
+	return fmt.Sprintf("%x", mac.Sum(nil)), nil
+}
+
+// rsaSigner sign payloads with RSASSA-PKCS1-v1_5 over SHA-256, base64 encoded
+type rsaSigner struct {
+	key *rsa.PrivateKey
+}
+
+// NewRSASigner init a signer from a PEM encoded PKCS#1 or PKCS#8 RSA private key
+func NewRSASigner(pemKey []byte) (Signer, error) {
+	key, err := parsePEMPrivateKey(pemKey)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("binance: private key is not an RSA key")
+	}
+	return &rsaSigner{key: rsaKey}, nil
+}
+
+func (s *rsaSigner) Sign(payload []byte) (string, error) {
+	hashed := sha256.Sum256(payload)
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// ed25519Signer sign payloads with Ed25519, base64 encoded
+type ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+// NewEd25519Signer init a signer from a PEM encoded PKCS#8 Ed25519 private key
+func NewEd25519Signer(pemKey []byte) (Signer, error) {
+	key, err := parsePEMPrivateKey(pemKey)
+	if err != nil {
+		return nil, err
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("binance: private key is not an Ed25519 key")
+	}
+	return &ed25519Signer{key: edKey}, nil
+}
+
+func (s *ed25519Signer) Sign(payload []byte) (string, error) {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, payload)), nil
+}
+
+func parsePEMPrivateKey(pemKey []byte) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, errors.New("binance: no PEM block found in private key")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	return nil, fmt.Errorf("binance: unsupported PEM block type %q", block.Type)
+}
+
+// signer return the signer of the client, HMAC of SecretKey by default
+func (c *Client) signer() Signer {
+	if c.Signer != nil {
+		return c.Signer
+	}
+	return NewHMACSigner(c.SecretKey)
+}
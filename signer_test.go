@@ -0,0 +1,120 @@
+package binance
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type signerTestSuite struct {
+	baseTestSuite
+}
+
+func TestSigner(t *testing.T) {
+	suite.Run(t, new(signerTestSuite))
+}
+
+func (s *signerTestSuite) TestHMACSigner() {
+	// example from the binance API documentation
+	signer := NewHMACSigner("NhqPtmdSJYdKjVHjA7PZj4Mge3R5YNiP1e3UZjInClVN65XAbvqqM6A7H5fATj0j")
+	sig, err := signer.Sign([]byte("symbol=LTCBTC&side=BUY&type=LIMIT&timeInForce=GTC&quantity=1&price=0.1&recvWindow=5000&timestamp=1499827319559"))
+	r := s.r()
+	r.NoError(err)
+	r.Equal("c8db56825ae71d6d79447849e617115f4a920fa2acdcab2b053c4b2838bd6b71", sig)
+}
+
+func (s *signerTestSuite) TestRSASigner() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	r := s.r()
+	r.NoError(err)
+	payload := []byte("symbol=LTCBTC&timestamp=1499827319559")
+
+	for _, block := range []*pem.Block{
+		{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)},
+		{Type: "PRIVATE KEY", Bytes: s.marshalPKCS8(key)},
+	} {
+		signer, err := NewRSASigner(pem.EncodeToMemory(block))
+		r.NoError(err)
+		sig, err := signer.Sign(payload)
+		r.NoError(err)
+		raw, err := base64.StdEncoding.DecodeString(sig)
+		r.NoError(err)
+		hashed := sha256.Sum256(payload)
+		r.NoError(rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hashed[:], raw))
+	}
+}
+
+func (s *signerTestSuite) TestEd25519Signer() {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	r := s.r()
+	r.NoError(err)
+	signer, err := NewEd25519Signer(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: s.marshalPKCS8(key)}))
+	r.NoError(err)
+
+	payload := []byte("symbol=LTCBTC&timestamp=1499827319559")
+	sig, err := signer.Sign(payload)
+	r.NoError(err)
+	raw, err := base64.StdEncoding.DecodeString(sig)
+	r.NoError(err)
+	r.True(ed25519.Verify(pub, payload, raw))
+}
+
+func (s *signerTestSuite) TestInvalidKeys() {
+	r := s.r()
+	_, err := NewRSASigner([]byte("not a key"))
+	r.Error(err)
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	r.NoError(err)
+	edPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: s.marshalPKCS8(key)})
+	_, err = NewRSASigner(edPEM)
+	r.Error(err)
+
+	_, err = NewEd25519Signer(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte{}}))
+	r.Error(err)
+}
+
+func (s *signerTestSuite) TestClientSigner() {
+	var payloads []string
+	s.client.Signer = SignerFunc(func(payload []byte) (string, error) {
+		payloads = append(payloads, string(payload))
+		return "a+b/c=", nil
+	})
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("a+b/c=", r.query.Get(signatureKey))
+	})
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(payloads, 1)
+	r.Contains(payloads[0], timestampKey+"=")
+}
+
+func (s *signerTestSuite) TestClientSignerError() {
+	s.client.Client.do = s.client.do
+	s.client.Signer = SignerFunc(func(payload []byte) (string, error) {
+		return "", errors.New("agent unavailable")
+	})
+
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().EqualError(err, "agent unavailable")
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *signerTestSuite) marshalPKCS8(key interface{}) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	s.r().NoError(err)
+	return der
+}
@@ -25,7 +25,10 @@ func (s *SystemStatusService) Do(ctx context.Context) (*SystemStatus, error) {
 
 	data, err := s.c.callAPI(ctx, r)
 	if err != nil {
-		return nil, APIError{
+		if IsAPIError(err) {
+			return nil, err
+		}
+		return nil, &APIError{
 			Code:    -1,
 			Message: err.Error(),
 		}
@@ -34,7 +37,7 @@ func (s *SystemStatusService) Do(ctx context.Context) (*SystemStatus, error) {
 	res := &SystemStatus{}
 	err = json.Unmarshal(data, res)
 	if err != nil {
-		return nil, APIError{
+		return nil, &APIError{
 			Code:    -1,
 			Message: err.Error(),
 		}
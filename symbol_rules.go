@@ -0,0 +1,143 @@
+package binance
+
+// SymbolRules define the price and quantity rules of a symbol, use it to
+// round prices and quantities before building an order
+type SymbolRules struct {
+	Symbol              string
+	MinPrice            Decimal
+	MaxPrice            Decimal
+	TickSize            Decimal
+	MinQuantity         Decimal
+	MaxQuantity         Decimal
+	StepSize            Decimal
+	MarketMinQuantity   Decimal
+	MarketMaxQuantity   Decimal
+	MarketStepSize      Decimal
+	MinNotional         Decimal
+	BaseAssetPrecision  int32
+	QuoteAssetPrecision int32
+}
+
+// NewSymbolRules init the rules from the filters of symbol
+func NewSymbolRules(symbol *ExchangeInfoSymbol) *SymbolRules {
+	rules := &SymbolRules{
+		Symbol:              symbol.Symbol,
+		BaseAssetPrecision:  int32(symbol.BaseAssetPrecision),
+		QuoteAssetPrecision: int32(symbol.QuotePrecision),
+	}
+	if f := symbol.PriceFilter(); f != nil {
+		rules.MinPrice, rules.MaxPrice, rules.TickSize = f.MinPrice, f.MaxPrice, f.TickSize
+	}
+	if f := symbol.LotSizeFilter(); f != nil {
+		rules.MinQuantity, rules.MaxQuantity, rules.StepSize = f.MinQuantity, f.MaxQuantity, f.StepSize
+	}
+	if f := symbol.MarketLotSizeFilter(); f != nil {
+		rules.MarketMinQuantity, rules.MarketMaxQuantity, rules.MarketStepSize = f.MinQuantity, f.MaxQuantity, f.StepSize
+	}
+	if f := symbol.MinNotionalFilter(); f != nil {
+		rules.MinNotional = f.MinNotional
+	}
+	return rules
+}
+
+// RoundPrice round price to a valid tick in the direction favorable to the
+// order side: down for buy orders, up for sell orders
+func (r *SymbolRules) RoundPrice(price Decimal, side SideType) Decimal {
+	if side == SideTypeSell {
+		return ceilToStep(price, r.MinPrice, r.TickSize, r.QuoteAssetPrecision)
+	}
+	return floorToStep(price, r.MinPrice, r.TickSize, r.QuoteAssetPrecision)
+}
+
+// RoundQuantity truncate quantity to a valid step of LOT_SIZE so the order
+// never exceeds the requested quantity
+func (r *SymbolRules) RoundQuantity(quantity Decimal) Decimal {
+	return floorToStep(quantity, r.MinQuantity, r.StepSize, r.BaseAssetPrecision)
+}
+
+// RoundMarketQuantity truncate quantity to a valid step of MARKET_LOT_SIZE,
+// falling back to LOT_SIZE when the market step is not set
+func (r *SymbolRules) RoundMarketQuantity(quantity Decimal) Decimal {
+	if r.MarketStepSize.IsZero() {
+		return r.RoundQuantity(quantity)
+	}
+	return floorToStep(quantity, r.MarketMinQuantity, r.MarketStepSize, r.BaseAssetPrecision)
+}
+
+// FormatPrice format price with the number of decimals of the tick size
+func (r *SymbolRules) FormatPrice(price Decimal) string {
+	return price.Round(stepPlaces(r.TickSize, r.QuoteAssetPrecision)).String()
+}
+
+// FormatQuantity format quantity with the number of decimals of the step size
+func (r *SymbolRules) FormatQuantity(quantity Decimal) string {
+	return quantity.Round(stepPlaces(r.StepSize, r.BaseAssetPrecision)).String()
+}
+
+// PriceString round price for side and format it for CreateOrderService.Price
+func (r *SymbolRules) PriceString(price Decimal, side SideType) string {
+	return r.FormatPrice(r.RoundPrice(price, side))
+}
+
+// QuantityString truncate quantity and format it for CreateOrderService.Quantity
+func (r *SymbolRules) QuantityString(quantity Decimal) string {
+	return r.FormatQuantity(r.RoundQuantity(quantity))
+}
+
+// MinQuantityForPrice return the smallest valid quantity satisfying both
+// LOT_SIZE and MIN_NOTIONAL at price
+func (r *SymbolRules) MinQuantityForPrice(price Decimal) Decimal {
+	quantity := r.MinQuantity
+	if !r.MinNotional.IsZero() && price.Sign() > 0 {
+		// divide with enough digits to not lose the step precision
+		places := stepPlaces(r.StepSize, r.BaseAssetPrecision) + 1
+		if needed := r.MinNotional.Div(price, places); needed.GreaterThan(quantity) {
+			quantity = needed
+		}
+	}
+	quantity = ceilToStep(quantity, r.MinQuantity, r.StepSize, r.BaseAssetPrecision)
+	// the division is rounded, bump by one step if the notional is still too low
+	if !r.StepSize.IsZero() && price.Mul(quantity).LessThan(r.MinNotional) {
+		quantity = quantity.Add(r.StepSize)
+	}
+	return quantity
+}
+
+// stepPlaces return the number of significant decimals of step, or
+// precision if step is not set
+func stepPlaces(step Decimal, precision int32) int32 {
+	if step.IsZero() {
+		return precision
+	}
+	places := step.Scale()
+	for places > 0 && step.Equal(step.Truncate(places-1)) {
+		places--
+	}
+	return places
+}
+
+// floorToStep round value down to base + n * step, or to precision decimals
+// if step is not set
+func floorToStep(value, base, step Decimal, precision int32) Decimal {
+	if step.IsZero() {
+		return value.Floor(precision)
+	}
+	rem := value.Sub(base).Mod(step)
+	if rem.Sign() < 0 {
+		rem = rem.Add(step)
+	}
+	return value.Sub(rem)
+}
+
+// ceilToStep round value up to base + n * step, or to precision decimals
+// if step is not set
+func ceilToStep(value, base, step Decimal, precision int32) Decimal {
+	if step.IsZero() {
+		return value.Ceil(precision)
+	}
+	floor := floorToStep(value, base, step, precision)
+	if floor.Equal(value) {
+		return floor
+	}
+	return floor.Add(step)
+}
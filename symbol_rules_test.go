@@ -0,0 +1,76 @@
+package binance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type symbolRulesTestSuite struct {
+	baseTestSuite
+	rules *SymbolRules
+}
+
+func TestSymbolRules(t *testing.T) {
+	suite.Run(t, new(symbolRulesTestSuite))
+}
+
+func (s *symbolRulesTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	res := new(ExchangeInfoResponse)
+	s.r().NoError(json.Unmarshal([]byte(exchangeInfoFiltersJSON), res))
+	s.rules = NewSymbolRules(res.Symbols[0])
+}
+
+func (s *symbolRulesTestSuite) TestNewSymbolRules() {
+	r := s.r()
+	r.Equal("ETHBTC", s.rules.Symbol)
+	r.Equal("0.00000100", s.rules.TickSize.String())
+	r.Equal("0.00100000", s.rules.StepSize.String())
+	r.Equal("3000.00000000", s.rules.MarketMaxQuantity.String())
+	r.Equal("0.00100000", s.rules.MinNotional.String())
+	r.Equal(int32(8), s.rules.BaseAssetPrecision)
+	r.Equal(int32(8), s.rules.QuoteAssetPrecision)
+}
+
+func (s *symbolRulesTestSuite) TestRoundPrice() {
+	r := s.r()
+	price := MustParseDecimal("0.05234567")
+	r.Equal("0.052345", s.rules.PriceString(price, SideTypeBuy))
+	r.Equal("0.052346", s.rules.PriceString(price, SideTypeSell))
+	r.Equal("0.052345", s.rules.PriceString(MustParseDecimal("0.052345"), SideTypeSell))
+	r.True(s.rules.RoundPrice(price, SideTypeBuy).Equal(MustParseDecimal("0.052345")))
+	r.Equal("1.000000", s.rules.FormatPrice(MustParseDecimal("1e0")))
+}
+
+func (s *symbolRulesTestSuite) TestRoundQuantity() {
+	r := s.r()
+	r.Equal("1.234", s.rules.QuantityString(MustParseDecimal("1.23456")))
+	r.Equal("0.000", s.rules.QuantityString(MustParseDecimal("0.0009")))
+	// market step is zero so the lot size step is used
+	r.True(s.rules.RoundMarketQuantity(MustParseDecimal("2.5559")).Equal(MustParseDecimal("2.555")))
+
+	s.rules.MarketStepSize = MustParseDecimal("0.1")
+	r.True(s.rules.RoundMarketQuantity(MustParseDecimal("2.5559")).Equal(MustParseDecimal("2.5")))
+}
+
+func (s *symbolRulesTestSuite) TestMinQuantityForPrice() {
+	r := s.r()
+	// 0.001 / 0.03 = 0.0333.. rounded up to 0.034
+	r.Equal("0.034", s.rules.FormatQuantity(s.rules.MinQuantityForPrice(MustParseDecimal("0.03"))))
+	// LOT_SIZE min quantity wins at high prices
+	r.Equal("0.001", s.rules.FormatQuantity(s.rules.MinQuantityForPrice(MustParseDecimal("50"))))
+
+	quantity := s.rules.MinQuantityForPrice(MustParseDecimal("0.07"))
+	r.False(MustParseDecimal("0.07").Mul(quantity).LessThan(s.rules.MinNotional))
+}
+
+func (s *symbolRulesTestSuite) TestNoStep() {
+	r := s.r()
+	rules := NewSymbolRules(&ExchangeInfoSymbol{Symbol: "ETHBTC", BaseAssetPrecision: 2, QuotePrecision: 3})
+	r.Equal("1.234", rules.PriceString(MustParseDecimal("1.2345"), SideTypeBuy))
+	r.Equal("1.235", rules.PriceString(MustParseDecimal("1.2341"), SideTypeSell))
+	r.Equal("1.23", rules.QuantityString(MustParseDecimal("1.239")))
+	r.True(rules.MinQuantityForPrice(MustParseDecimal("10")).IsZero())
+}
@@ -3,6 +3,7 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"time"
 )
 
 // ListBookTickersService list all book tickers
@@ -37,6 +38,26 @@ type BookTicker struct {
 	AskQuantity string `json:"askQty"`
 }
 
+// BidPriceDecimal return bid price as a decimal, zero if it is empty or malformed
+func (b *BookTicker) BidPriceDecimal() Decimal {
+	return parseDecimalOrZero(b.BidPrice)
+}
+
+// BidQuantityDecimal return bid quantity as a decimal, zero if it is empty or malformed
+func (b *BookTicker) BidQuantityDecimal() Decimal {
+	return parseDecimalOrZero(b.BidQuantity)
+}
+
+// AskPriceDecimal return ask price as a decimal, zero if it is empty or malformed
+func (b *BookTicker) AskPriceDecimal() Decimal {
+	return parseDecimalOrZero(b.AskPrice)
+}
+
+// AskQuantityDecimal return ask quantity as a decimal, zero if it is empty or malformed
+func (b *BookTicker) AskQuantityDecimal() Decimal {
+	return parseDecimalOrZero(b.AskQuantity)
+}
+
 // BookTickerService list symbol's book ticker
 type BookTickerService struct {
 	c      *Client
@@ -96,6 +117,11 @@ type SymbolPrice struct {
 	Price  string `json:"price"`
 }
 
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (s *SymbolPrice) PriceDecimal() Decimal {
+	return parseDecimalOrZero(s.Price)
+}
+
 // PriceChangeStatsService show stats of price change in last 24 hours
 type PriceChangeStatsService struct {
 	c      *Client
@@ -149,6 +175,76 @@ type PriceChangeStats struct {
 	Count              int64  `json:"count"`
 }
 
+// OpenTimeUTC return open time as a UTC time
+func (p *PriceChangeStats) OpenTimeUTC() time.Time {
+	return MillisToTime(p.OpenTime)
+}
+
+// CloseTimeUTC return close time as a UTC time
+func (p *PriceChangeStats) CloseTimeUTC() time.Time {
+	return MillisToTime(p.CloseTime)
+}
+
+// PriceChangeDecimal return price change as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) PriceChangeDecimal() Decimal {
+	return parseDecimalOrZero(p.PriceChange)
+}
+
+// PriceChangePercentDecimal return price change percent as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) PriceChangePercentDecimal() Decimal {
+	return parseDecimalOrZero(p.PriceChangePercent)
+}
+
+// WeightedAvgPriceDecimal return weighted avg price as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) WeightedAvgPriceDecimal() Decimal {
+	return parseDecimalOrZero(p.WeightedAvgPrice)
+}
+
+// PrevClosePriceDecimal return prev close price as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) PrevClosePriceDecimal() Decimal {
+	return parseDecimalOrZero(p.PrevClosePrice)
+}
+
+// LastPriceDecimal return last price as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) LastPriceDecimal() Decimal {
+	return parseDecimalOrZero(p.LastPrice)
+}
+
+// BidPriceDecimal return bid price as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) BidPriceDecimal() Decimal {
+	return parseDecimalOrZero(p.BidPrice)
+}
+
+// AskPriceDecimal return ask price as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) AskPriceDecimal() Decimal {
+	return parseDecimalOrZero(p.AskPrice)
+}
+
+// OpenPriceDecimal return open price as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) OpenPriceDecimal() Decimal {
+	return parseDecimalOrZero(p.OpenPrice)
+}
+
+// HighPriceDecimal return high price as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) HighPriceDecimal() Decimal {
+	return parseDecimalOrZero(p.HighPrice)
+}
+
+// LowPriceDecimal return low price as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) LowPriceDecimal() Decimal {
+	return parseDecimalOrZero(p.LowPrice)
+}
+
+// VolumeDecimal return volume as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) VolumeDecimal() Decimal {
+	return parseDecimalOrZero(p.Volume)
+}
+
+// QuoteVolumeDecimal return quote volume as a decimal, zero if it is empty or malformed
+func (p *PriceChangeStats) QuoteVolumeDecimal() Decimal {
+	return parseDecimalOrZero(p.QuoteVolume)
+}
+
 // ListPriceChangeStatsService show stats of price change in last 24 hours
 type ListPriceChangeStatsService struct {
 	c *Client
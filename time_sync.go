@@ -0,0 +1,139 @@
+package binance
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// timeSyncRetryDelay is the delay before retrying a failed sync, doubled on
+// every consecutive failure
+const timeSyncRetryDelay = time.Second
+
+// timeSync keep the offset between the server clock and the local clock
+type timeSync struct {
+	mu       sync.Mutex
+	syncMu   sync.Mutex
+	offset   time.Duration
+	latency  time.Duration
+	syncTime time.Time
+	failures int
+	failTime time.Time
+}
+
+func (t *timeSync) get() (offset, latency time.Duration, syncTime time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.offset, t.latency, t.syncTime
+}
+
+func (t *timeSync) set(offset, latency time.Duration, syncTime time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.offset, t.latency, t.syncTime = offset, latency, syncTime
+	t.failures = 0
+}
+
+func (t *timeSync) fail(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failures++
+	t.failTime = now
+}
+
+// stale check if the offset is older than interval, a failed sync is not
+// retried before its backoff delay
+func (t *timeSync) stale(interval time.Duration, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.failures > 0 && now.Sub(t.failTime) < backoffDelay(t.failures, timeSyncRetryDelay, interval, 0) {
+		return false
+	}
+	return now.Sub(t.syncTime) >= interval
+}
+
+// SyncTime measure the offset between the server clock and the local clock
+// with ServerTimeService. The offset is applied to the timestamp of every
+// signed request.
+func (c *Client) SyncTime(ctx context.Context) error {
+	c.timeSync.syncMu.Lock()
+	defer c.timeSync.syncMu.Unlock()
+	return c.syncTime(ctx)
+}
+
+// syncTime measure the offset, the caller holds syncMu
+func (c *Client) syncTime(ctx context.Context) error {
+	start := time.Now()
+	serverTime, err := c.NewServerTimeService().Do(ctx)
+	if err != nil {
+		c.timeSync.fail(time.Now())
+		return err
+	}
+	end := time.Now()
+	latency := end.Sub(start)
+	// assume the server stamped its response halfway through the round trip
+	offset := MillisToTime(serverTime).Sub(start.Add(latency / 2))
+	c.timeSync.set(offset, latency, end)
+	c.debug("time synced, offset: %s, latency: %s", offset, latency)
+	return nil
+}
+
+// StartTimeSync sync the time offset now and then every interval until ctx
+// is done. Failed syncs are retried with backoff.
+func (c *Client) StartTimeSync(ctx context.Context, interval time.Duration) {
+	go func() {
+		failures := 0
+		for {
+			delay := interval
+			if err := c.SyncTime(ctx); err != nil {
+				failures++
+				delay = backoffDelay(failures, timeSyncRetryDelay, interval, 0.5)
+				c.debug("failed to sync time: %s, retrying in %s", err, delay)
+			} else {
+				failures = 0
+			}
+			if sleepContext(ctx, delay) != nil {
+				return
+			}
+		}
+	}()
+}
+
+// TimeOffset return the measured offset of the server clock relative to the local clock
+func (c *Client) TimeOffset() time.Duration {
+	offset, _, _ := c.timeSync.get()
+	return offset
+}
+
+// TimeSyncLatency return the round trip time measured by the last SyncTime call
+func (c *Client) TimeSyncLatency() time.Duration {
+	_, latency, _ := c.timeSync.get()
+	return latency
+}
+
+// timestamp return the current server timestamp in milliseconds estimated with the time offset
+func (c *Client) timestamp() int64 {
+	return TimeToMillis(time.Now().Add(c.TimeOffset()))
+}
+
+// syncTimeIfStale refresh the time offset if it is older than TimeSyncInterval,
+// concurrent calls share a single sync
+func (c *Client) syncTimeIfStale(ctx context.Context) {
+	if c.TimeSyncInterval <= 0 || !c.timeSync.stale(c.TimeSyncInterval, time.Now()) {
+		return
+	}
+	c.timeSync.syncMu.Lock()
+	defer c.timeSync.syncMu.Unlock()
+	// another call may have synced while this one was waiting
+	if !c.timeSync.stale(c.TimeSyncInterval, time.Now()) {
+		return
+	}
+	if err := c.syncTime(ctx); err != nil {
+		c.debug("failed to sync time: %s", err)
+	}
+}
+
+func isTimestampError(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.Code == ErrCodeInvalidTimestamp
+}
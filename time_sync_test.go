@@ -0,0 +1,155 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type timeSyncTestSuite struct {
+	baseTestSuite
+}
+
+func TestTimeSync(t *testing.T) {
+	suite.Run(t, new(timeSyncTestSuite))
+}
+
+func (s *timeSyncTestSuite) mockServerTime(offset time.Duration) {
+	serverTime := time.Now().Add(offset).UnixNano() / int64(time.Millisecond)
+	s.mockDoOnce([]byte(fmt.Sprintf(`{"serverTime": %d}`, serverTime)), nil, http.StatusOK)
+}
+
+func (s *timeSyncTestSuite) requestTimestamp(r *request) time.Time {
+	ts, err := strconv.ParseInt(r.query.Get(timestampKey), 10, 64)
+	s.r().NoError(err)
+	return time.Unix(0, ts*int64(time.Millisecond))
+}
+
+func (s *timeSyncTestSuite) TestSyncTime() {
+	s.mockServerTime(5 * time.Second)
+	s.mockDoOnce([]byte(`{}`), nil, http.StatusOK)
+
+	r := s.r()
+	r.NoError(s.client.SyncTime(newContext()))
+	r.InDelta(float64(5*time.Second), float64(s.client.TimeOffset()), float64(100*time.Millisecond))
+	r.True(s.client.TimeSyncLatency() >= 0)
+
+	var timestamp time.Time
+	s.assertReq(func(r *request) {
+		timestamp = s.requestTimestamp(r)
+	})
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	r.WithinDuration(time.Now().Add(5*time.Second), timestamp, 100*time.Millisecond)
+}
+
+func (s *timeSyncTestSuite) TestResyncOnTimestampError() {
+	s.mockDoOnce([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`), nil, http.StatusBadRequest)
+	s.mockServerTime(-3 * time.Second)
+	s.mockDoOnce([]byte(`{"symbol":"LTCBTC","orderId":1}`), nil, http.StatusOK)
+
+	var timestamps []time.Time
+	s.assertReq(func(r *request) {
+		if r.query.Get(timestampKey) != "" {
+			timestamps = append(timestamps, s.requestTimestamp(r))
+		}
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.EqualValues(1, res.OrderID)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+	r.Len(timestamps, 2)
+	r.WithinDuration(timestamps[0].Add(-3*time.Second), timestamps[1], 100*time.Millisecond)
+}
+
+func (s *timeSyncTestSuite) TestResyncOnlyOnce() {
+	timestampErr := []byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`)
+	s.mockDoOnce(timestampErr, nil, http.StatusBadRequest)
+	s.mockServerTime(0)
+	s.mockDoOnce(timestampErr, nil, http.StatusBadRequest)
+
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.EqualValues(ErrCodeInvalidTimestamp, err.(*APIError).Code)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *timeSyncTestSuite) TestSyncTimeInterval() {
+	s.client.TimeSyncInterval = time.Hour
+	s.mockServerTime(time.Second)
+	s.mockDoOnce([]byte(`{}`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`{}`), nil, http.StatusOK)
+
+	r := s.r()
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+	r.InDelta(float64(time.Second), float64(s.client.TimeOffset()), float64(100*time.Millisecond))
+}
+
+func (s *timeSyncTestSuite) TestSyncTimeIntervalConcurrent() {
+	s.client.TimeSyncInterval = time.Hour
+	s.mockServerTime(time.Second)
+	for i := 0; i < 5; i++ {
+		s.mockDoOnce([]byte(`{}`), nil, http.StatusOK)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.client.NewGetAccountService().Do(newContext())
+		}()
+	}
+	wg.Wait()
+	s.client.AssertNumberOfCalls(s.T(), "do", 6)
+	s.r().InDelta(float64(time.Second), float64(s.client.TimeOffset()), float64(100*time.Millisecond))
+}
+
+func (s *timeSyncTestSuite) TestSyncTimeIntervalBackoff() {
+	s.client.TimeSyncInterval = time.Hour
+	s.mockDoOnce([]byte(`{"code":-1001,"msg":"Internal error"}`), nil, http.StatusInternalServerError)
+	s.mockDoOnce([]byte(`{}`), nil, http.StatusOK)
+	s.mockDoOnce([]byte(`{}`), nil, http.StatusOK)
+
+	r := s.r()
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+	r.False(s.client.timeSync.stale(time.Hour, time.Now()))
+	r.True(s.client.timeSync.stale(time.Hour, time.Now().Add(timeSyncRetryDelay)))
+}
+
+func (s *timeSyncTestSuite) TestStartTimeSync() {
+	s.mockServerTime(time.Second)
+	s.mockServerTime(2 * time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	s.assertReq(func(r *request) {
+		// stop after the second sync
+		if calls++; calls == 2 {
+			cancel()
+		}
+	})
+
+	s.client.StartTimeSync(ctx, time.Millisecond)
+	<-ctx.Done()
+	for s.client.TimeOffset() < 1500*time.Millisecond {
+		time.Sleep(time.Millisecond)
+	}
+	s.r().InDelta(float64(2*time.Second), float64(s.client.TimeOffset()), float64(100*time.Millisecond))
+}
@@ -0,0 +1,21 @@
+package binance
+
+import "time"
+
+// MillisToTime convert a timestamp in milliseconds as used by the API to a
+// UTC time, 0 is converted to the zero time
+func MillisToTime(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ms/1e3, (ms%1e3)*int64(time.Millisecond)).UTC()
+}
+
+// TimeToMillis convert t to a timestamp in milliseconds as used by the API,
+// the zero time is converted to 0
+func TimeToMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()*1e3 + int64(t.Nanosecond())/int64(time.Millisecond)
+}
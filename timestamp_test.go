@@ -0,0 +1,62 @@
+package binance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type timestampTestSuite struct {
+	baseTestSuite
+}
+
+func TestTimestamp(t *testing.T) {
+	suite.Run(t, new(timestampTestSuite))
+}
+
+func (s *timestampTestSuite) TestConversion() {
+	r := s.r()
+	t := MillisToTime(1499040000123)
+	r.Equal(time.Date(2017, 7, 3, 0, 0, 0, 123*int(time.Millisecond), time.UTC), t)
+	r.Equal(time.UTC, t.Location())
+	r.Equal(int64(1499040000123), TimeToMillis(t))
+
+	// sub millisecond precision is truncated, time zones are irrelevant
+	local := time.Date(2017, 7, 3, 8, 0, 0, 123999999, time.FixedZone("UTC+8", 8*3600))
+	r.Equal(int64(1499040000123), TimeToMillis(local))
+
+	r.True(MillisToTime(0).IsZero())
+	r.Equal(int64(0), TimeToMillis(time.Time{}))
+	r.Equal(time.Date(1969, 12, 31, 23, 59, 59, 999*int(time.Millisecond), time.UTC), MillisToTime(-1))
+	r.Equal(int64(-1), TimeToMillis(MillisToTime(-1)))
+}
+
+func (s *timestampTestSuite) TestAccessors() {
+	r := s.r()
+	k := &Kline{OpenTime: 1499040000000, CloseTime: 1499644799999}
+	r.Equal(time.Date(2017, 7, 3, 0, 0, 0, 0, time.UTC), k.OpenTimeUTC())
+	r.Equal(int64(1499644799999), TimeToMillis(k.CloseTimeUTC()))
+	r.True((&Order{}).TimeUTC().IsZero())
+	r.Equal(MillisToTime(1508198532000), (&Deposit{InsertTime: 1508198532000}).InsertTimeUTC())
+	r.Equal(MillisToTime(42), (&WsExecutionReportEvent{TransactionTime: 42}).TransactionTimeUTC())
+}
+
+func (s *timestampTestSuite) TestSetters() {
+	s.mockDo([]byte(`[]`), nil)
+	defer s.assertDo()
+	start := time.Date(2017, 7, 3, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    "LTCBTC",
+			"interval":  KlineInterval1m,
+			"startTime": 1499040000000,
+			"endTime":   1499043600000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	_, err := s.client.NewKlinesService().Symbol("LTCBTC").Interval(KlineInterval1m).
+		Since(start).Until(end).Do(newContext())
+	s.r().NoError(err)
+}
@@ -3,6 +3,7 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"time"
 )
 
 // ListTradesService list trades
@@ -67,6 +68,21 @@ type HistoricalTrade struct {
 	IsBestMatch  bool   `json:"isBestMatch"`
 }
 
+// TimeUTC return time as a UTC time
+func (h *HistoricalTrade) TimeUTC() time.Time {
+	return MillisToTime(h.Time)
+}
+
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (h *HistoricalTrade) PriceDecimal() Decimal {
+	return parseDecimalOrZero(h.Price)
+}
+
+// QuantityDecimal return quantity as a decimal, zero if it is empty or malformed
+func (h *HistoricalTrade) QuantityDecimal() Decimal {
+	return parseDecimalOrZero(h.Quantity)
+}
+
 // Trade define trade info
 type Trade struct {
 	ID              int64  `json:"id"`
@@ -80,6 +96,26 @@ type Trade struct {
 	IsBestMatch     bool   `json:"isBestMatch"`
 }
 
+// TimeUTC return time as a UTC time
+func (t *Trade) TimeUTC() time.Time {
+	return MillisToTime(t.Time)
+}
+
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (t *Trade) PriceDecimal() Decimal {
+	return parseDecimalOrZero(t.Price)
+}
+
+// QuantityDecimal return quantity as a decimal, zero if it is empty or malformed
+func (t *Trade) QuantityDecimal() Decimal {
+	return parseDecimalOrZero(t.Quantity)
+}
+
+// CommissionDecimal return commission as a decimal, zero if it is empty or malformed
+func (t *Trade) CommissionDecimal() Decimal {
+	return parseDecimalOrZero(t.Commission)
+}
+
 // AggTradesService list aggregate trades
 type AggTradesService struct {
 	c         *Client
@@ -114,6 +150,16 @@ func (s *AggTradesService) EndTime(endTime int64) *AggTradesService {
 	return s
 }
 
+// Since set startTime from t
+func (s *AggTradesService) Since(t time.Time) *AggTradesService {
+	return s.StartTime(TimeToMillis(t))
+}
+
+// Until set endTime from t
+func (s *AggTradesService) Until(t time.Time) *AggTradesService {
+	return s.EndTime(TimeToMillis(t))
+}
+
 // Limit set limit
 func (s *AggTradesService) Limit(limit int) *AggTradesService {
 	s.limit = &limit
@@ -163,6 +209,21 @@ type AggTrade struct {
 	IsBestPriceMatch bool   `json:"M"`
 }
 
+// TimestampUTC return timestamp as a UTC time
+func (a *AggTrade) TimestampUTC() time.Time {
+	return MillisToTime(a.Timestamp)
+}
+
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (a *AggTrade) PriceDecimal() Decimal {
+	return parseDecimalOrZero(a.Price)
+}
+
+// QuantityDecimal return quantity as a decimal, zero if it is empty or malformed
+func (a *AggTrade) QuantityDecimal() Decimal {
+	return parseDecimalOrZero(a.Quantity)
+}
+
 // HistoricalTradesService trades
 type HistoricalTradesService struct {
 	c      *Client
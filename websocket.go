@@ -1,16 +1,115 @@
 package binance
 
 import (
-	"strings"
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
 
+// WsState define the connection state of a WsService
+type WsState int
+
+// Websocket connection states
+const (
+	WsStateConnecting WsState = iota + 1
+	WsStateConnected
+	WsStateReconnecting
+	WsStateClosed
+)
+
+// String return the name of the state
+func (s WsState) String() string {
+	switch s {
+	case WsStateConnecting:
+		return "CONNECTING"
+	case WsStateConnected:
+		return "CONNECTED"
+	case WsStateReconnecting:
+		return "RECONNECTING"
+	case WsStateClosed:
+		return "CLOSED"
+	}
+	return "IDLE"
+}
+
+// WsStateHandler handle the state transitions of a WsService, err is the
+// cause of reconnections and of closing after the policy is exhausted
+type WsStateHandler func(state WsState, err error)
+
+// ErrWsClosed is returned when connecting a closed WsService
+var ErrWsClosed = errors.New("binance: websocket closed")
+
+// ErrWsServing is returned when serving a WsService which is already served
+var ErrWsServing = errors.New("binance: websocket already served")
+
+// errWsNotConnected is the cause of the first connection when Serve is
+// called without Connect
+var errWsNotConnected = errors.New("binance: websocket not connected")
+
+// Keepalive defaults, Binance closes connections which do not answer its
+// pings within 10 minutes
+const (
+	defaultWsPingInterval = 30 * time.Second
+	defaultWsReadTimeout  = time.Minute
+	wsWriteTimeout        = 10 * time.Second
+)
+
+// WsReconnectPolicy define how a WsService reconnects after a disconnect
+type WsReconnectPolicy struct {
+	// MaxAttempts is the number of consecutive failed reconnections before
+	// giving up, 0 means never give up
+	MaxAttempts int
+	// BaseDelay is the delay before the first reconnection, doubled on every failure
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two reconnections
+	MaxDelay time.Duration
+	// Jitter is the fraction (0..1) of each delay that is randomized
+	Jitter float64
+}
+
+// DefaultWsReconnectPolicy return a reconnect policy retrying forever
+func DefaultWsReconnectPolicy() *WsReconnectPolicy {
+	return &WsReconnectPolicy{
+		BaseDelay: time.Second,
+		MaxDelay:  time.Minute,
+		Jitter:    0.5,
+	}
+}
+
+// WsService define a websocket stream. The stream is reconnected after
+// disconnects according to its reconnect policy.
 type WsService struct {
-	endpoint   string
-	handler    WsHandler
-	errHandler WsErrorHandler
-	c          *websocket.Conn
+	endpoint        string
+	handler         WsHandler
+	errHandler      WsErrorHandler
+	stateHandler    WsStateHandler
+	reconnectPolicy *WsReconnectPolicy
+	pingInterval    time.Duration
+	readTimeout     time.Duration
+	// err is returned by Connect when the stream parameters are invalid
+	err error
+	// onConnect is called with every new connection before it is used
+	onConnect func(c *websocket.Conn) error
+	// onDisconnect is called with every connection passed to onConnect once
+	// it is not used anymore
+	onDisconnect func(c *websocket.Conn)
+
+	mu          sync.Mutex
+	wmu         sync.Mutex
+	c           *websocket.Conn
+	state       WsState
+	closed      bool
+	serving     bool
+	stop        chan struct{}
+	done        chan struct{}
+	doneOnce    sync.Once
+	lastMessage time.Time
+	rtt         time.Duration
 }
 
 func newWsService(endpoint string, handler WsHandler, errHandler WsErrorHandler) *WsService {
@@ -23,38 +122,366 @@ func newWsService(endpoint string, handler WsHandler, errHandler WsErrorHandler)
 	}
 
 	return &WsService{
-		endpoint:   endpoint,
-		handler:    handler,
-		errHandler: errHandler,
+		endpoint:        endpoint,
+		handler:         handler,
+		errHandler:      errHandler,
+		reconnectPolicy: DefaultWsReconnectPolicy(),
+		pingInterval:    defaultWsPingInterval,
+		readTimeout:     defaultWsReadTimeout,
+		stop:            make(chan struct{}),
+		done:            make(chan struct{}),
 	}
 }
 
+// ReconnectPolicy set the reconnect policy, nil disables reconnections
+func (w *WsService) ReconnectPolicy(policy *WsReconnectPolicy) *WsService {
+	w.reconnectPolicy = policy
+	return w
+}
+
+// PingInterval set the interval between the pings sent to the server,
+// 0 disables them
+func (w *WsService) PingInterval(interval time.Duration) *WsService {
+	w.pingInterval = interval
+	return w
+}
+
+// ReadTimeout set how long the connection may stay without any frame before
+// it is considered dead and reconnected, 0 disables it. It must be longer
+// than the ping interval.
+func (w *WsService) ReadTimeout(timeout time.Duration) *WsService {
+	w.readTimeout = timeout
+	return w
+}
+
+// LastMessageTime return when the last message was received
+func (w *WsService) LastMessageTime() time.Time {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.lastMessage
+}
+
+// RTT return the round trip time measured by the last ping, 0 if no pong
+// was received
+func (w *WsService) RTT() time.Duration {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.rtt
+}
+
+// StateHandler set the handler of the state transitions
+func (w *WsService) StateHandler(handler WsStateHandler) *WsService {
+	w.stateHandler = handler
+	return w
+}
+
+// State return the connection state
+func (w *WsService) State() WsState {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.state
+}
+
+func (w *WsService) setState(state WsState, err error) {
+	w.mu.Lock()
+	if w.state == WsStateClosed && state == WsStateClosed {
+		w.mu.Unlock()
+		return
+	}
+	w.state = state
+	w.mu.Unlock()
+	if w.stateHandler != nil {
+		w.stateHandler(state, err)
+	}
+}
+
+func (w *WsService) isClosed() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.closed
+}
+
+func (w *WsService) conn() *websocket.Conn {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.c
+}
+
+// dial open a new connection to the endpoint
+func (w *WsService) dial() error {
+	c, _, err := websocket.DefaultDialer.Dial(w.endpoint, nil)
+	if err != nil {
+		return err
+	}
+	c.SetPingHandler(func(appData string) error {
+		w.extendReadDeadline(c)
+		err := c.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(wsWriteTimeout))
+		if err == websocket.ErrCloseSent {
+			return nil
+		}
+		return err
+	})
+	c.SetPongHandler(func(appData string) error {
+		w.extendReadDeadline(c)
+		if sent, err := strconv.ParseInt(appData, 10, 64); err == nil {
+			w.mu.Lock()
+			w.rtt = time.Since(time.Unix(0, sent))
+			w.mu.Unlock()
+		}
+		return nil
+	})
+	if w.onConnect != nil && !w.isClosed() {
+		if err := w.onConnect(c); err != nil {
+			w.disconnect(c)
+			return err
+		}
+	}
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		w.disconnect(c)
+		return ErrWsClosed
+	}
+	w.c = c
+	w.mu.Unlock()
+	return nil
+}
+
+// disconnect close c and tell the owner of the service it is not used anymore
+func (w *WsService) disconnect(c *websocket.Conn) {
+	c.Close()
+	if w.onDisconnect != nil {
+		w.onDisconnect(c)
+	}
+}
+
+// writeJSON send v as a text message on c, writes are serialized since a
+// connection supports one concurrent writer
+func (w *WsService) writeJSON(c *websocket.Conn, v interface{}) error {
+	w.wmu.Lock()
+	defer w.wmu.Unlock()
+	c.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return c.WriteJSON(v)
+}
+
+// Close close the connection and stop reconnections, Serve and Run return
+// once the current message is handled
 func (w *WsService) Close() {
-	w.c.Close()
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return
+	}
+	w.closed = true
+	close(w.stop)
+	c := w.c
+	serving := w.serving
+	w.mu.Unlock()
+	// the state is reported before Serve can return
+	w.setState(WsStateClosed, nil)
+	if !serving {
+		// no read loop ends with the connection opened by Connect
+		if c != nil {
+			w.disconnect(c)
+		}
+		w.finish()
+	} else if c != nil {
+		c.Close()
+	}
+}
+
+// Done return a channel closed when the service is closed and not served anymore
+func (w *WsService) Done() <-chan struct{} {
+	return w.done
+}
+
+func (w *WsService) finish() {
+	w.doneOnce.Do(func() {
+		close(w.done)
+	})
 }
 
+// Connect open the connection
 func (w *WsService) Connect() error {
-	var err error
-	w.c, _, err = websocket.DefaultDialer.Dial(w.endpoint, nil)
-	w.c.SetPingHandler(nil)
-	w.c.SetPongHandler(nil)
+	if w.err != nil {
+		return w.err
+	}
+	if w.isClosed() {
+		return ErrWsClosed
+	}
+	w.setState(WsStateConnecting, nil)
+	if err := w.dial(); err != nil {
+		w.setState(WsStateClosed, err)
+		return err
+	}
+	w.setState(WsStateConnected, nil)
+	return nil
+}
 
+// Run connect and serve the stream until ctx is done or the reconnect
+// policy is exhausted. A failed first connection is retried like a
+// disconnect. It returns ctx.Err() after a cancellation.
+func (w *WsService) Run(ctx context.Context) error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.isClosed() {
+		return ErrWsClosed
+	}
+	// claim the service before dialing so a concurrent Run neither opens
+	// a connection nor watches its ctx
+	if err := w.claim(); err != nil {
+		return err
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			w.Close()
+		case <-w.stop:
+		}
+	}()
+	w.setState(WsStateConnecting, nil)
+	if err := w.dial(); err != nil && !w.isClosed() {
+		w.errHandler(err)
+		w.setState(WsStateReconnecting, err)
+	} else if err == nil {
+		w.setState(WsStateConnected, nil)
+	}
+	err := w.serve()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
 	return err
 }
 
-func (w *WsService) Serve() {
-	defer w.c.Close()
+// Serve read messages until Close is called or the reconnect policy is
+// exhausted. Read errors are passed to the error handler once per
+// disconnect before reconnecting. It returns nil after Close and the last
+// error when the service gives up.
+func (w *WsService) Serve() error {
+	if err := w.claim(); err != nil {
+		return err
+	}
+	return w.serve()
+}
+
+// claim mark the service as served, it can only be served once
+func (w *WsService) claim() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.serving {
+		return ErrWsServing
+	}
+	w.serving = true
+	return nil
+}
+
+// serve read and reconnect until the service is closed or gives up
+func (w *WsService) serve() error {
+	defer w.finish()
+
+	for {
+		err := errWsNotConnected
+		if c := w.conn(); c != nil {
+			err = w.read(c)
+		}
+		if w.isClosed() {
+			return nil
+		}
+		if err != errWsNotConnected {
+			w.errHandler(err)
+		}
+		if err = w.reconnect(err); err != nil {
+			w.Close()
+			if err == ErrWsClosed {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// read dispatch the messages of c to the handler until a read fails or
+// times out
+func (w *WsService) read(c *websocket.Conn) error {
+	defer w.disconnect(c)
+	done := make(chan struct{})
+	defer close(done)
+	if w.pingInterval > 0 {
+		go w.keepalive(c, done)
+	}
 	for {
-		_, message, err := w.c.ReadMessage()
+		w.extendReadDeadline(c)
+		_, message, err := c.ReadMessage()
 		if err != nil {
-			if strings.Contains(err.Error(), "use of closed network connection") {
+			return err
+		}
+		w.mu.Lock()
+		w.lastMessage = time.Now()
+		w.mu.Unlock()
+		w.handler(message)
+	}
+}
+
+// extendReadDeadline push the read deadline of c after any received frame
+func (w *WsService) extendReadDeadline(c *websocket.Conn) {
+	if w.readTimeout > 0 {
+		c.SetReadDeadline(time.Now().Add(w.readTimeout))
+	}
+}
+
+// keepalive ping the server until done is closed, the send time is the
+// payload to measure the round trip time when the pong is received
+func (w *WsService) keepalive(c *websocket.Conn, done chan struct{}) {
+	ticker := time.NewTicker(w.pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case t := <-ticker.C:
+			payload := strconv.FormatInt(t.UnixNano(), 10)
+			if err := c.WriteControl(websocket.PingMessage, []byte(payload), time.Now().Add(wsWriteTimeout)); err != nil {
+				// the read fails as well and triggers the reconnection
 				return
 			}
+		}
+	}
+}
 
-			w.errHandler(err)
-		} else {
-			w.handler(message)
+// reconnect dial again after a disconnect caused by cause. It returns
+// ErrWsClosed when the service is closed and the last error when the
+// reconnect policy is exhausted.
+func (w *WsService) reconnect(cause error) error {
+	p := w.reconnectPolicy
+	if p == nil {
+		w.setState(WsStateClosed, cause)
+		return cause
+	}
+	if w.State() != WsStateReconnecting {
+		w.setState(WsStateReconnecting, cause)
+	}
+	for attempt := 1; ; attempt++ {
+		t := time.NewTimer(backoffDelay(attempt, p.BaseDelay, p.MaxDelay, p.Jitter))
+		select {
+		case <-w.stop:
+			t.Stop()
+			return ErrWsClosed
+		case <-t.C:
+		}
+		err := w.dial()
+		if err == nil {
+			w.setState(WsStateConnected, nil)
+			return nil
+		}
+		if w.isClosed() {
+			return ErrWsClosed
+		}
+		if p.MaxAttempts > 0 && attempt >= p.MaxAttempts {
+			w.setState(WsStateClosed, err)
+			return err
 		}
+		w.setState(WsStateReconnecting, err)
 	}
 }
 
@@ -63,7 +490,7 @@ type WsHandler func(message []byte)
 type WsErrorHandler func(err error)
 
 var defaultWsErrorHandler = func(err error) {
-	panic(err)
+	log.Printf("binance: websocket error: %v", err)
 }
 
 var defaultWsHandler = func(message []byte) {}
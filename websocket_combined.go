@@ -0,0 +1,129 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// wsMaxCombinedStreams is the number of streams Binance accepts on a single
+// combined connection
+var wsMaxCombinedStreams = 1024
+
+// WsStream define a named stream of the websocket API with the handler of
+// its messages, to serve alone or combined with other streams
+type WsStream struct {
+	name    string
+	handler WsHandler
+	// err is returned by Connect when the stream parameters are invalid
+	err error
+}
+
+// Name return the name of the stream like ltcbtc@aggTrade
+func (s *WsStream) Name() string {
+	return s.name
+}
+
+func newWsStreamService(stream *WsStream, errHandler WsErrorHandler) *WsService {
+	ws := newWsService(fmt.Sprintf("%s/%s", baseURL, stream.name), stream.handler, errHandler)
+	ws.err = stream.err
+	return ws
+}
+
+// wsCombinedEvent define the messages of combined connections, either the
+// envelope of a stream event or the response to a request
+type wsCombinedEvent struct {
+	Stream string          `json:"stream"`
+	Data   json.RawMessage `json:"data"`
+	ID     *int64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *APIError       `json:"error"`
+}
+
+// WsCombinedService serve many streams over combined connections, the
+// streams are sharded when they exceed the limit of a connection
+type WsCombinedService struct {
+	shards []*WsService
+}
+
+// WsCombinedServe serve streams over as few combined connections as possible.
+// Messages are unwrapped and dispatched to the handler of their stream.
+func WsCombinedServe(streams []*WsStream, errHandler WsErrorHandler) *WsCombinedService {
+	s := new(WsCombinedService)
+	var names []string
+	handlers := make(map[string][]WsHandler)
+	var err error
+	flush := func() {
+		if len(names) == 0 {
+			return
+		}
+		endpoint := fmt.Sprintf("%s?streams=%s", combinedBaseURL, strings.Join(names, "/"))
+		ws := newWsService(endpoint, newWsCombinedHandler(handlers), errHandler)
+		ws.err = err
+		s.shards = append(s.shards, ws)
+		names, handlers, err = nil, make(map[string][]WsHandler), nil
+	}
+	for _, stream := range streams {
+		if _, ok := handlers[stream.name]; !ok {
+			if len(names) == wsMaxCombinedStreams {
+				flush()
+			}
+			names = append(names, stream.name)
+		}
+		handlers[stream.name] = append(handlers[stream.name], stream.handler)
+		if err == nil {
+			err = stream.err
+		}
+	}
+	flush()
+	return s
+}
+
+func newWsCombinedHandler(handlers map[string][]WsHandler) WsHandler {
+	return func(message []byte) {
+		event := new(wsCombinedEvent)
+		if err := json.Unmarshal(message, event); err != nil {
+			return
+		}
+		for _, h := range handlers[event.Stream] {
+			h(event.Data)
+		}
+	}
+}
+
+// Shards return the connections of the streams, to set their reconnect
+// policy or state handler before running them
+func (s *WsCombinedService) Shards() []*WsService {
+	return s.shards
+}
+
+// Run serve all the connections until ctx is done, a connection which gives
+// up does not stop the other ones. It returns the first error of the
+// connections, or ctx.Err() after a cancellation.
+func (s *WsCombinedService) Run(ctx context.Context) error {
+	errs := make([]error, len(s.shards))
+	var wg sync.WaitGroup
+	for i, ws := range s.shards {
+		wg.Add(1)
+		go func(i int, ws *WsService) {
+			defer wg.Done()
+			errs[i] = ws.Run(ctx)
+		}(i, ws)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil && err != ctx.Err() {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// Close close all the connections
+func (s *WsCombinedService) Close() {
+	for _, ws := range s.shards {
+		ws.Close()
+	}
+}
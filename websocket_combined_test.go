@@ -0,0 +1,94 @@
+package binance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type websocketCombinedTestSuite struct {
+	baseTestSuite
+}
+
+func TestWebsocketCombined(t *testing.T) {
+	suite.Run(t, new(websocketCombinedTestSuite))
+}
+
+func (s *websocketCombinedTestSuite) TestShards() {
+	defer func(max int) {
+		wsMaxCombinedStreams = max
+	}(wsMaxCombinedStreams)
+	wsMaxCombinedStreams = 2
+
+	streams := []*WsStream{
+		WsAggTradeStream("BNBBTC", nil),
+		WsKlineStream("BNBBTC", KlineInterval1m, nil),
+		WsAggTradeStream("bnbbtc", nil),
+		WsDiffDepthStream("LTCBTC", nil),
+		WsKlineStream("LTCBTC", KlineInterval("2m"), nil),
+	}
+	r := s.r()
+	r.Equal("bnbbtc@aggTrade", streams[0].Name())
+	c := WsCombinedServe(streams, nil)
+	shards := c.Shards()
+	r.Len(shards, 2)
+	r.Equal(combinedBaseURL+"?streams=bnbbtc@aggTrade/bnbbtc@kline_1m", shards[0].endpoint)
+	r.NoError(shards[0].err)
+	r.Equal(combinedBaseURL+"?streams=ltcbtc@depth/ltcbtc@kline_2m", shards[1].endpoint)
+	r.Error(shards[1].err)
+	r.Equal(shards[1].err, shards[1].Connect())
+}
+
+func (s *websocketCombinedTestSuite) TestDispatch() {
+	server := newWsTestServer(1, func(n int, c *websocket.Conn) {
+		c.WriteMessage(websocket.TextMessage, []byte(`{"stream":"unknown@trade","data":{}}`))
+		c.WriteMessage(websocket.TextMessage, []byte(`not json`))
+		c.WriteMessage(websocket.TextMessage, []byte(`{"stream":"bnbbtc@aggTrade","data":{"e":"aggTrade","s":"BNBBTC","a":12345,"p":"0.001"}}`))
+		c.WriteMessage(websocket.TextMessage, []byte(`{"stream":"bnbbtc@kline_1m","data":{"e":"kline","s":"BNBBTC","k":{"i":"1m","c":"0.0010"}}}`))
+		waitClose(c)
+	})
+	defer server.Close()
+	defer func(url string) {
+		combinedBaseURL = url
+	}(combinedBaseURL)
+	combinedBaseURL = server.endpoint()
+
+	trades := make(chan *WsAggTradeEvent, 10)
+	klines := make(chan *WsKlineEvent, 10)
+	tradeHandler := func(event *WsAggTradeEvent) {
+		trades <- event
+	}
+	c := WsCombinedServe([]*WsStream{
+		WsAggTradeStream("BNBBTC", tradeHandler),
+		WsKlineStream("BNBBTC", KlineInterval1m, func(event *WsKlineEvent) {
+			klines <- event
+		}),
+		WsAggTradeStream("BNBBTC", tradeHandler),
+	}, func(err error) {})
+	r := s.r()
+	r.Len(c.Shards(), 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Run(ctx)
+	}()
+	for i := 0; i < 2; i++ {
+		trade := <-trades
+		r.Equal("BNBBTC", trade.Symbol)
+		r.Equal(int64(12345), trade.AggTradeID)
+	}
+	kline := <-klines
+	r.Equal(KlineInterval1m, kline.Kline.Interval)
+	r.Equal("0.0010", kline.Kline.Close)
+	cancel()
+	r.Equal(context.Canceled, <-done)
+	r.Empty(trades)
+}
+
+func (s *websocketCombinedTestSuite) TestRunError() {
+	c := WsCombinedServe([]*WsStream{WsKlineStream("BNBBTC", KlineInterval("2m"), nil)}, nil)
+	c.Close()
+	s.r().Error(c.Run(context.Background()))
+}
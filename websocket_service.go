@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 )
 
 var (
-	baseURL = "wss://stream2.binance.com:9443/ws"
+	baseURL         = "wss://stream2.binance.com:9443/ws"
+	combinedBaseURL = "wss://stream2.binance.com:9443/stream"
 )
 
 // WsDepthHandler handle websocket depth event
@@ -17,7 +19,12 @@ type WsAllPriceTickerHandler func(event WsTickersEvent)
 
 // WsPartialBookDepthServe Top <levels> bids and asks, pushed every second. Valid <levels> are 5, 10, or 20.
 func WsPartialBookDepthServe(symbol string, levels string, handler WsPartialBookDepthHandler, errHandler WsErrorHandler) *WsService {
-	endpoint := fmt.Sprintf("%s/%s@depth%s", baseURL, strings.ToLower(symbol), levels)
+	return newWsStreamService(WsPartialBookDepthStream(symbol, levels, handler), errHandler)
+}
+
+// WsPartialBookDepthStream define the partial book depth stream of a symbol
+func WsPartialBookDepthStream(symbol string, levels string, handler WsPartialBookDepthHandler) *WsStream {
+	name := fmt.Sprintf("%s@depth%s", strings.ToLower(symbol), levels)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -47,7 +54,7 @@ func WsPartialBookDepthServe(symbol string, levels string, handler WsPartialBook
 		handler(event)
 	}
 
-	return newWsService(endpoint, wsHandler, errHandler)
+	return &WsStream{name: name, handler: wsHandler}
 }
 
 // WsPartialBookDepthEvent define websocket partial orderbook depth event
@@ -59,7 +66,12 @@ type WsPartialBookDepthEvent struct {
 
 // WsDiffDepthServe Order book price and quantity depth updates used to locally manage an order book pushed every second.
 func WsDiffDepthServe(symbol string, handler WsDiffDepthHandler, errHandler WsErrorHandler) *WsService {
-	endpoint := fmt.Sprintf("%s/%s@depth", baseURL, strings.ToLower(symbol))
+	return newWsStreamService(WsDiffDepthStream(symbol, handler), errHandler)
+}
+
+// WsDiffDepthStream define the diff depth stream of a symbol
+func WsDiffDepthStream(symbol string, handler WsDiffDepthHandler) *WsStream {
+	name := fmt.Sprintf("%s@depth", strings.ToLower(symbol))
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -70,6 +82,7 @@ func WsDiffDepthServe(symbol string, handler WsDiffDepthHandler, errHandler WsEr
 		event.Event = j.Get("e").MustString()
 		event.Time = j.Get("E").MustInt64()
 		event.Symbol = j.Get("s").MustString()
+		event.FirstUpdateID = j.Get("U").MustInt64()
 		event.UpdateID = j.Get("u").MustInt64()
 		bidsLen := len(j.Get("b").MustArray())
 		event.Bids = make([]Bid, bidsLen)
@@ -92,25 +105,37 @@ func WsDiffDepthServe(symbol string, handler WsDiffDepthHandler, errHandler WsEr
 		handler(event)
 	}
 
-	return newWsService(endpoint, wsHandler, errHandler)
+	return &WsStream{name: name, handler: wsHandler}
 }
 
 // WsDepthEvent define websocket depth event
 type WsDiffDepthEvent struct {
-	Event    string `json:"e"`
-	Time     int64  `json:"E"`
-	Symbol   string `json:"s"`
-	UpdateID int64  `json:"u"`
-	Bids     []Bid  `json:"b"`
-	Asks     []Ask  `json:"a"`
+	Event         string `json:"e"`
+	Time          int64  `json:"E"`
+	Symbol        string `json:"s"`
+	FirstUpdateID int64  `json:"U"`
+	UpdateID      int64  `json:"u"`
+	Bids          []Bid  `json:"b"`
+	Asks          []Ask  `json:"a"`
+}
+
+// TimeUTC return time as a UTC time
+func (e *WsDiffDepthEvent) TimeUTC() time.Time {
+	return MillisToTime(e.Time)
 }
 
 // WsKlineHandler handle websocket kline event
 type WsKlineHandler func(event *WsKlineEvent)
 
-// WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
-func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler WsErrorHandler) *WsService {
-	endpoint := fmt.Sprintf("%s/%s@kline_%s", baseURL, strings.ToLower(symbol), interval)
+// WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 1h.
+// Connect fails if the interval is invalid.
+func WsKlineServe(symbol string, interval KlineInterval, handler WsKlineHandler, errHandler WsErrorHandler) *WsService {
+	return newWsStreamService(WsKlineStream(symbol, interval, handler), errHandler)
+}
+
+// WsKlineStream define the kline stream of a symbol and interval
+func WsKlineStream(symbol string, interval KlineInterval, handler WsKlineHandler) *WsStream {
+	name := fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -119,7 +144,7 @@ func WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHan
 		}
 		handler(event)
 	}
-	return newWsService(endpoint, wsHandler, errHandler)
+	return &WsStream{name: name, handler: wsHandler, err: interval.Validate()}
 }
 
 // WsKlineEvent define websocket kline event
@@ -130,24 +155,79 @@ type WsKlineEvent struct {
 	Kline  WsKline `json:"k"`
 }
 
+// TimeUTC return time as a UTC time
+func (e *WsKlineEvent) TimeUTC() time.Time {
+	return MillisToTime(e.Time)
+}
+
 // WsKline define websocket kline
 type WsKline struct {
-	StartTime            int64  `json:"t"`
-	EndTime              int64  `json:"T"`
-	Symbol               string `json:"s"`
-	Interval             string `json:"i"`
-	FirstTradeID         int64  `json:"f"`
-	LastTradeID          int64  `json:"L"`
-	Open                 string `json:"o"`
-	Close                string `json:"c"`
-	High                 string `json:"h"`
-	Low                  string `json:"l"`
-	Volume               string `json:"v"`
-	TradeNum             int64  `json:"n"`
-	IsFinal              bool   `json:"x"`
-	QuoteVolume          string `json:"q"`
-	ActiveBuyVolume      string `json:"V"`
-	ActiveBuyQuoteVolume string `json:"Q"`
+	StartTime            int64         `json:"t"`
+	EndTime              int64         `json:"T"`
+	Symbol               string        `json:"s"`
+	Interval             KlineInterval `json:"i"`
+	FirstTradeID         int64         `json:"f"`
+	LastTradeID          int64         `json:"L"`
+	Open                 string        `json:"o"`
+	Close                string        `json:"c"`
+	High                 string        `json:"h"`
+	Low                  string        `json:"l"`
+	Volume               string        `json:"v"`
+	TradeNum             int64         `json:"n"`
+	IsFinal              bool          `json:"x"`
+	QuoteVolume          string        `json:"q"`
+	ActiveBuyVolume      string        `json:"V"`
+	ActiveBuyQuoteVolume string        `json:"Q"`
+}
+
+// StartTimeUTC return start time as a UTC time
+func (k *WsKline) StartTimeUTC() time.Time {
+	return MillisToTime(k.StartTime)
+}
+
+// EndTimeUTC return end time as a UTC time
+func (k *WsKline) EndTimeUTC() time.Time {
+	return MillisToTime(k.EndTime)
+}
+
+// OpenDecimal return open as a decimal, zero if it is empty or malformed
+func (k *WsKline) OpenDecimal() Decimal {
+	return parseDecimalOrZero(k.Open)
+}
+
+// CloseDecimal return close as a decimal, zero if it is empty or malformed
+func (k *WsKline) CloseDecimal() Decimal {
+	return parseDecimalOrZero(k.Close)
+}
+
+// HighDecimal return high as a decimal, zero if it is empty or malformed
+func (k *WsKline) HighDecimal() Decimal {
+	return parseDecimalOrZero(k.High)
+}
+
+// LowDecimal return low as a decimal, zero if it is empty or malformed
+func (k *WsKline) LowDecimal() Decimal {
+	return parseDecimalOrZero(k.Low)
+}
+
+// VolumeDecimal return volume as a decimal, zero if it is empty or malformed
+func (k *WsKline) VolumeDecimal() Decimal {
+	return parseDecimalOrZero(k.Volume)
+}
+
+// QuoteVolumeDecimal return quote volume as a decimal, zero if it is empty or malformed
+func (k *WsKline) QuoteVolumeDecimal() Decimal {
+	return parseDecimalOrZero(k.QuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal return active buy volume as a decimal, zero if it is empty or malformed
+func (k *WsKline) ActiveBuyVolumeDecimal() Decimal {
+	return parseDecimalOrZero(k.ActiveBuyVolume)
+}
+
+// ActiveBuyQuoteVolumeDecimal return active buy quote volume as a decimal, zero if it is empty or malformed
+func (k *WsKline) ActiveBuyQuoteVolumeDecimal() Decimal {
+	return parseDecimalOrZero(k.ActiveBuyQuoteVolume)
 }
 
 // WsAggTradeHandler handle websocket aggregate trade event
@@ -155,7 +235,12 @@ type WsAggTradeHandler func(event *WsAggTradeEvent)
 
 // WsAggTradeServe serve websocket aggregate handler with a symbol
 func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler WsErrorHandler) *WsService {
-	endpoint := fmt.Sprintf("%s/%s@aggTrade", baseURL, strings.ToLower(symbol))
+	return newWsStreamService(WsAggTradeStream(symbol, handler), errHandler)
+}
+
+// WsAggTradeStream define the aggregate trade stream of a symbol
+func WsAggTradeStream(symbol string, handler WsAggTradeHandler) *WsStream {
+	name := fmt.Sprintf("%s@aggTrade", strings.ToLower(symbol))
 	wsHandler := func(message []byte) {
 		event := new(WsAggTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -165,7 +250,7 @@ func WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler WsErro
 		handler(event)
 	}
 
-	return newWsService(endpoint, wsHandler, errHandler)
+	return &WsStream{name: name, handler: wsHandler}
 }
 
 // WsAggTradeEvent define websocket aggregate trade event
@@ -183,12 +268,124 @@ type WsAggTradeEvent struct {
 	Placeholder           bool   `json:"M"` // add this field to avoid case insensitive unmarshaling
 }
 
+// TimeUTC return time as a UTC time
+func (e *WsAggTradeEvent) TimeUTC() time.Time {
+	return MillisToTime(e.Time)
+}
+
+// TradeTimeUTC return trade time as a UTC time
+func (e *WsAggTradeEvent) TradeTimeUTC() time.Time {
+	return MillisToTime(e.TradeTime)
+}
+
+// PriceDecimal return price as a decimal, zero if it is empty or malformed
+func (e *WsAggTradeEvent) PriceDecimal() Decimal {
+	return parseDecimalOrZero(e.Price)
+}
+
+// QuantityDecimal return quantity as a decimal, zero if it is empty or malformed
+func (e *WsAggTradeEvent) QuantityDecimal() Decimal {
+	return parseDecimalOrZero(e.Quantity)
+}
+
 // WsUserDataServe serve user data handler with listen key
 func WsUserDataServe(listenKey string, handler WsHandler, errHandler WsErrorHandler) *WsService {
 	endpoint := fmt.Sprintf("%s/%s", baseURL, listenKey)
 	return newWsService(endpoint, handler, errHandler)
 }
 
+// WsExecutionReportEvent define the executionReport event of the user data
+// stream, decode the messages of WsUserDataServe with json.Unmarshal
+type WsExecutionReportEvent struct {
+	Event                    string          `json:"e"`
+	Time                     int64           `json:"E"`
+	Symbol                   string          `json:"s"`
+	ClientOrderID            string          `json:"c"`
+	Side                     SideType        `json:"S"`
+	Type                     OrderType       `json:"o"`
+	TimeInForce              TimeInForce     `json:"f"`
+	Quantity                 string          `json:"q"`
+	Price                    string          `json:"p"`
+	StopPrice                string          `json:"P"`
+	IcebergQuantity          string          `json:"F"`
+	OrderListID              int64           `json:"g"`
+	OrigClientOrderID        string          `json:"C"`
+	ExecutionType            ExecutionType   `json:"x"`
+	Status                   OrderStatusType `json:"X"`
+	RejectReason             RejectReason    `json:"r"`
+	OrderID                  int64           `json:"i"`
+	LastExecutedQuantity     string          `json:"l"`
+	ExecutedQuantity         string          `json:"z"`
+	LastExecutedPrice        string          `json:"L"`
+	Commission               string          `json:"n"`
+	CommissionAsset          string          `json:"N"`
+	TransactionTime          int64           `json:"T"`
+	TradeID                  int64           `json:"t"`
+	IsInOrderBook            bool            `json:"w"`
+	IsMaker                  bool            `json:"m"`
+	CreateTime               int64           `json:"O"`
+	CummulativeQuoteQuantity string          `json:"Z"`
+	LastQuoteQuantity        string          `json:"Y"`
+	QuoteOrderQuantity       string          `json:"Q"`
+}
+
+// TimeUTC return time as a UTC time
+func (e *WsExecutionReportEvent) TimeUTC() time.Time {
+	return MillisToTime(e.Time)
+}
+
+// TransactionTimeUTC return transaction time as a UTC time
+func (e *WsExecutionReportEvent) TransactionTimeUTC() time.Time {
+	return MillisToTime(e.TransactionTime)
+}
+
+// CreateTimeUTC return create time as a UTC time
+func (e *WsExecutionReportEvent) CreateTimeUTC() time.Time {
+	return MillisToTime(e.CreateTime)
+}
+
+// UnmarshalJSON decode the event by exact key, encoding/json would match
+// keys like I and M to the fields of i and m
+func (e *WsExecutionReportEvent) UnmarshalJSON(data []byte) error {
+	j, err := newJSON(data)
+	if err != nil {
+		return err
+	}
+	*e = WsExecutionReportEvent{
+		Event:                    j.Get("e").MustString(),
+		Time:                     j.Get("E").MustInt64(),
+		Symbol:                   j.Get("s").MustString(),
+		ClientOrderID:            j.Get("c").MustString(),
+		Side:                     SideType(j.Get("S").MustString()),
+		Type:                     OrderType(j.Get("o").MustString()),
+		TimeInForce:              TimeInForce(j.Get("f").MustString()),
+		Quantity:                 j.Get("q").MustString(),
+		Price:                    j.Get("p").MustString(),
+		StopPrice:                j.Get("P").MustString(),
+		IcebergQuantity:          j.Get("F").MustString(),
+		OrderListID:              j.Get("g").MustInt64(),
+		OrigClientOrderID:        j.Get("C").MustString(),
+		ExecutionType:            ExecutionType(j.Get("x").MustString()),
+		Status:                   OrderStatusType(j.Get("X").MustString()),
+		RejectReason:             RejectReason(j.Get("r").MustString()),
+		OrderID:                  j.Get("i").MustInt64(),
+		LastExecutedQuantity:     j.Get("l").MustString(),
+		ExecutedQuantity:         j.Get("z").MustString(),
+		LastExecutedPrice:        j.Get("L").MustString(),
+		Commission:               j.Get("n").MustString(),
+		CommissionAsset:          j.Get("N").MustString(),
+		TransactionTime:          j.Get("T").MustInt64(),
+		TradeID:                  j.Get("t").MustInt64(),
+		IsInOrderBook:            j.Get("w").MustBool(),
+		IsMaker:                  j.Get("m").MustBool(),
+		CreateTime:               j.Get("O").MustInt64(),
+		CummulativeQuoteQuantity: j.Get("Z").MustString(),
+		LastQuoteQuantity:        j.Get("Y").MustString(),
+		QuoteOrderQuantity:       j.Get("Q").MustString(),
+	}
+	return nil
+}
+
 type WsTickersEvent []*WsTickerEvent
 
 type WsTickerEvent struct {
@@ -217,9 +414,53 @@ type WsTickerEvent struct {
 	// TotalTrade            int64  `json:"n"`
 }
 
+// EventTimeUTC return event time as a UTC time
+func (e *WsTickerEvent) EventTimeUTC() time.Time {
+	return MillisToTime(e.EventTime)
+}
+
+// PriceChangeDecimal return price change as a decimal, zero if it is empty or malformed
+func (e *WsTickerEvent) PriceChangeDecimal() Decimal {
+	return parseDecimalOrZero(e.PriceChange)
+}
+
+// PriceChangePercentDecimal return price change percent as a decimal, zero if it is empty or malformed
+func (e *WsTickerEvent) PriceChangePercentDecimal() Decimal {
+	return parseDecimalOrZero(e.PriceChangePercent)
+}
+
+// BestBidPriceDecimal return best bid price as a decimal, zero if it is empty or malformed
+func (e *WsTickerEvent) BestBidPriceDecimal() Decimal {
+	return parseDecimalOrZero(e.BestBidPrice)
+}
+
+// BestBidQtyDecimal return best bid qty as a decimal, zero if it is empty or malformed
+func (e *WsTickerEvent) BestBidQtyDecimal() Decimal {
+	return parseDecimalOrZero(e.BestBidQty)
+}
+
+// BestAskPriceDecimal return best ask price as a decimal, zero if it is empty or malformed
+func (e *WsTickerEvent) BestAskPriceDecimal() Decimal {
+	return parseDecimalOrZero(e.BestAskPrice)
+}
+
+// BestAskQtyDecimal return best ask qty as a decimal, zero if it is empty or malformed
+func (e *WsTickerEvent) BestAskQtyDecimal() Decimal {
+	return parseDecimalOrZero(e.BestAskQty)
+}
+
+// TotalTradeQuoteVolumeDecimal return total trade quote volume as a decimal, zero if it is empty or malformed
+func (e *WsTickerEvent) TotalTradeQuoteVolumeDecimal() Decimal {
+	return parseDecimalOrZero(e.TotalTradeQuoteVolume)
+}
+
 // WsAggTradeServe serve websocket aggregate handler with a symbol
 func WsAllPriceTickerServe(handler WsAllPriceTickerHandler, errHandler WsErrorHandler) *WsService {
-	endpoint := fmt.Sprintf("%s/!ticker@arr", baseURL)
+	return newWsStreamService(WsAllPriceTickerStream(handler), errHandler)
+}
+
+// WsAllPriceTickerStream define the ticker stream of all symbols
+func WsAllPriceTickerStream(handler WsAllPriceTickerHandler) *WsStream {
 	wsHandler := func(message []byte) {
 		event := make(WsTickersEvent, 0, 250)
 		err := json.Unmarshal(message, &event)
@@ -229,5 +470,5 @@ func WsAllPriceTickerServe(handler WsAllPriceTickerHandler, errHandler WsErrorHa
 		handler(event)
 	}
 
-	return newWsService(endpoint, wsHandler, errHandler)
+	return &WsStream{name: "!ticker@arr", handler: wsHandler}
 }
@@ -0,0 +1,361 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// ErrWsDisconnected is returned by requests whose connection was lost before
+// the response was received
+var ErrWsDisconnected = errors.New("binance: websocket disconnected before the response")
+
+// wsMessageInterval spaces the messages sent to the server, Binance accepts
+// 5 messages per second including pings and pongs so one is left for them
+var wsMessageInterval = 250 * time.Millisecond
+
+// Websocket request methods
+const (
+	wsMethodSubscribe         = "SUBSCRIBE"
+	wsMethodUnsubscribe       = "UNSUBSCRIBE"
+	wsMethodListSubscriptions = "LIST_SUBSCRIPTIONS"
+)
+
+// wsStreamRequest define a request sent on a combined connection
+type wsStreamRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params,omitempty"`
+	ID     int64    `json:"id"`
+}
+
+type wsStreamResponse struct {
+	result json.RawMessage
+	err    error
+}
+
+// wsPendingRequest define a request registered on the connection it is sent on
+type wsPendingRequest struct {
+	msg  *wsStreamRequest
+	conn *websocket.Conn
+	resp chan *wsStreamResponse
+}
+
+// wsStreamHandler wrap a handler so the ones added by a call can be told
+// apart from the ones of other calls on the same stream
+type wsStreamHandler struct {
+	handler WsHandler
+}
+
+// WsStreamManager serve streams on a single combined connection and
+// subscribe or unsubscribe them while it is open. Its set of streams is
+// authoritative: it is subscribed again after every reconnection.
+type WsStreamManager struct {
+	ws *WsService
+
+	mu       sync.Mutex
+	conn     *websocket.Conn
+	handlers map[string][]*wsStreamHandler
+	pending  map[int64]chan *wsStreamResponse
+	nextID   int64
+	nextSend time.Time
+}
+
+// NewWsStreamManager init a stream manager without any stream
+func NewWsStreamManager(errHandler WsErrorHandler) *WsStreamManager {
+	m := &WsStreamManager{
+		handlers: make(map[string][]*wsStreamHandler),
+		pending:  make(map[int64]chan *wsStreamResponse),
+	}
+	m.ws = newWsService(combinedBaseURL, m.handle, errHandler)
+	m.ws.onConnect = m.replay
+	m.ws.onDisconnect = m.disconnect
+	return m
+}
+
+// Service return the connection, to set its reconnect policy or state
+// handler before running it
+func (m *WsStreamManager) Service() *WsService {
+	return m.ws
+}
+
+// Run serve the streams until ctx is done, see WsService.Run
+func (m *WsStreamManager) Run(ctx context.Context) error {
+	return m.ws.Run(ctx)
+}
+
+// Close close the connection
+func (m *WsStreamManager) Close() {
+	m.ws.Close()
+}
+
+// Streams return the names of the managed streams
+func (m *WsStreamManager) Streams() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.streams()
+}
+
+func (m *WsStreamManager) streams() []string {
+	names := make([]string, 0, len(m.handlers))
+	for name := range m.handlers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Subscribe add streams to the set and wait for the server to acknowledge
+// the new ones. Without an open connection the streams are only added to
+// the set, they are subscribed when the connection opens. Streams rejected
+// by the server are removed from the set again, on other errors like a lost
+// connection they are kept and subscribed after the next connection.
+func (m *WsStreamManager) Subscribe(ctx context.Context, streams ...*WsStream) error {
+	for _, stream := range streams {
+		if stream.err != nil {
+			return stream.err
+		}
+	}
+	added := make(map[string][]*wsStreamHandler)
+	var names []string
+	m.mu.Lock()
+	for _, stream := range streams {
+		if len(m.handlers[stream.name]) == 0 {
+			names = append(names, stream.name)
+		}
+		h := &wsStreamHandler{handler: stream.handler}
+		m.handlers[stream.name] = append(m.handlers[stream.name], h)
+		added[stream.name] = append(added[stream.name], h)
+	}
+	var req *wsPendingRequest
+	if len(names) > 0 {
+		req = m.newRequest(wsMethodSubscribe, names)
+	}
+	m.mu.Unlock()
+	if req == nil {
+		return nil
+	}
+	_, err := m.do(ctx, req)
+	if _, ok := asAPIError(err); ok {
+		m.mu.Lock()
+		for name, handlers := range added {
+			m.removeHandlers(name, handlers)
+		}
+		m.mu.Unlock()
+	}
+	if err == errWsNotConnected {
+		return nil
+	}
+	return err
+}
+
+// Unsubscribe remove streams from the set by name and wait for the server
+// to acknowledge it. Without an open connection the streams are only
+// removed from the set, they are not subscribed when the connection opens.
+// Streams the server refuses to unsubscribe are added back to the set, on
+// other errors like a lost connection they stay removed.
+func (m *WsStreamManager) Unsubscribe(ctx context.Context, names ...string) error {
+	removed := make(map[string][]*wsStreamHandler)
+	var params []string
+	m.mu.Lock()
+	for _, name := range names {
+		if handlers, ok := m.handlers[name]; ok {
+			delete(m.handlers, name)
+			removed[name] = handlers
+			params = append(params, name)
+		}
+	}
+	var req *wsPendingRequest
+	if len(params) > 0 {
+		req = m.newRequest(wsMethodUnsubscribe, params)
+	}
+	m.mu.Unlock()
+	if req == nil {
+		return nil
+	}
+	_, err := m.do(ctx, req)
+	if _, ok := asAPIError(err); ok {
+		m.mu.Lock()
+		for name, handlers := range removed {
+			m.handlers[name] = append(handlers, m.handlers[name]...)
+		}
+		m.mu.Unlock()
+	}
+	if err == errWsNotConnected {
+		return nil
+	}
+	return err
+}
+
+// ListSubscriptions return the streams subscribed on the server side
+func (m *WsStreamManager) ListSubscriptions(ctx context.Context) ([]string, error) {
+	m.mu.Lock()
+	req := m.newRequest(wsMethodListSubscriptions, nil)
+	m.mu.Unlock()
+	result, err := m.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0)
+	if err = json.Unmarshal(result, &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// removeHandlers remove handlers from the stream and the stream from the
+// set if no handler is left, the caller holds mu
+func (m *WsStreamManager) removeHandlers(name string, handlers []*wsStreamHandler) {
+	kept := make([]*wsStreamHandler, 0, len(m.handlers[name]))
+	for _, h := range m.handlers[name] {
+		found := false
+		for _, removed := range handlers {
+			found = found || h == removed
+		}
+		if !found {
+			kept = append(kept, h)
+		}
+	}
+	if len(kept) == 0 {
+		delete(m.handlers, name)
+		return
+	}
+	m.handlers[name] = kept
+}
+
+// newRequest register a request on the current connection, the caller holds
+// mu so the request and the change of the set it carries are either both
+// before or both after a reconnection
+func (m *WsStreamManager) newRequest(method string, params []string) *wsPendingRequest {
+	m.nextID++
+	req := &wsPendingRequest{
+		msg:  &wsStreamRequest{Method: method, Params: params, ID: m.nextID},
+		conn: m.conn,
+		resp: make(chan *wsStreamResponse, 1),
+	}
+	if req.conn != nil {
+		m.pending[req.msg.ID] = req.resp
+	}
+	return req
+}
+
+func (m *WsStreamManager) forget(req *wsPendingRequest) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.pending, req.msg.ID)
+}
+
+// do send a registered request and wait for its response
+func (m *WsStreamManager) do(ctx context.Context, req *wsPendingRequest) (json.RawMessage, error) {
+	if req.conn == nil {
+		return nil, errWsNotConnected
+	}
+	defer m.forget(req)
+	if err := m.throttle(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.ws.writeJSON(req.conn, req.msg); err != nil {
+		return nil, err
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-req.resp:
+		return r.result, r.err
+	}
+}
+
+// throttle wait for the next slot to send a message
+func (m *WsStreamManager) throttle(ctx context.Context) error {
+	m.mu.Lock()
+	now := time.Now()
+	if m.nextSend.Before(now) {
+		m.nextSend = now
+	}
+	wait := m.nextSend.Sub(now)
+	m.nextSend = m.nextSend.Add(wsMessageInterval)
+	m.mu.Unlock()
+	return sleepContext(ctx, wait)
+}
+
+// replay switch to the new connection c before it is used: the requests of
+// the previous connection fail and all the streams are subscribed on c
+func (m *WsStreamManager) replay(c *websocket.Conn) error {
+	m.mu.Lock()
+	lost := m.pending
+	m.pending = make(map[int64]chan *wsStreamResponse)
+	m.conn = c
+	names := m.streams()
+	var id int64
+	if len(names) > 0 {
+		m.nextID++
+		id = m.nextID
+	}
+	m.mu.Unlock()
+	for _, resp := range lost {
+		resp <- &wsStreamResponse{err: ErrWsDisconnected}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	if err := m.throttle(context.Background()); err != nil {
+		return err
+	}
+	return m.ws.writeJSON(c, &wsStreamRequest{Method: wsMethodSubscribe, Params: names, ID: id})
+}
+
+// disconnect fail the requests of c once its read loop ended or the
+// service stopped, the requests sent later wait for the next connection
+func (m *WsStreamManager) disconnect(c *websocket.Conn) {
+	m.mu.Lock()
+	if m.conn != c {
+		m.mu.Unlock()
+		return
+	}
+	lost := m.pending
+	m.pending = make(map[int64]chan *wsStreamResponse)
+	m.conn = nil
+	m.mu.Unlock()
+	for _, resp := range lost {
+		resp <- &wsStreamResponse{err: ErrWsDisconnected}
+	}
+}
+
+// handle pass responses to their request and events to the handlers of
+// their stream. Errors without a request waiting for them go to the error
+// handler.
+func (m *WsStreamManager) handle(message []byte) {
+	event := new(wsCombinedEvent)
+	if err := json.Unmarshal(message, event); err != nil {
+		return
+	}
+	if event.ID == nil && event.Error == nil {
+		m.mu.Lock()
+		handlers := m.handlers[event.Stream]
+		m.mu.Unlock()
+		for _, h := range handlers {
+			h.handler(event.Data)
+		}
+		return
+	}
+	r := &wsStreamResponse{result: event.Result}
+	if event.Error != nil {
+		r.err = event.Error
+	}
+	var resp chan *wsStreamResponse
+	if event.ID != nil {
+		m.mu.Lock()
+		resp = m.pending[*event.ID]
+		delete(m.pending, *event.ID)
+		m.mu.Unlock()
+	}
+	if resp != nil {
+		resp <- r
+	} else if r.err != nil {
+		m.ws.errHandler(r.err)
+	}
+}
@@ -0,0 +1,248 @@
+package binance
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type websocketStreamManagerTestSuite struct {
+	baseTestSuite
+	requests chan *wsStreamRequest
+	url      string
+	interval time.Duration
+}
+
+func TestWebsocketStreamManager(t *testing.T) {
+	suite.Run(t, new(websocketStreamManagerTestSuite))
+}
+
+func (s *websocketStreamManagerTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.requests = make(chan *wsStreamRequest, 10)
+	s.url, s.interval = combinedBaseURL, wsMessageInterval
+	wsMessageInterval = time.Millisecond
+}
+
+func (s *websocketStreamManagerTestSuite) TearDownTest() {
+	combinedBaseURL, wsMessageInterval = s.url, s.interval
+}
+
+// serve answer the requests of the connections with respond, the
+// connection n is closed after closeAfter[n-1] requests if set
+func (s *websocketStreamManagerTestSuite) serve(maxConns int, closeAfter []int, respond func(c *websocket.Conn, req *wsStreamRequest)) *wsTestServer {
+	server := newWsTestServer(maxConns, func(n int, c *websocket.Conn) {
+		for i := 1; ; i++ {
+			req := new(wsStreamRequest)
+			if err := c.ReadJSON(req); err != nil {
+				return
+			}
+			s.requests <- req
+			respond(c, req)
+			if n <= len(closeAfter) && i == closeAfter[n-1] {
+				return
+			}
+		}
+	})
+	combinedBaseURL = server.endpoint()
+	return server
+}
+
+func ackRequest(c *websocket.Conn, req *wsStreamRequest) {
+	c.WriteJSON(map[string]interface{}{"result": nil, "id": req.ID})
+}
+
+func (s *websocketStreamManagerTestSuite) run(m *WsStreamManager) (context.CancelFunc, chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- m.Run(ctx)
+	}()
+	for m.Service().State() != WsStateConnected {
+		time.Sleep(time.Millisecond)
+	}
+	return cancel, done
+}
+
+func (s *websocketStreamManagerTestSuite) TestSubscribe() {
+	server := s.serve(1, nil, func(c *websocket.Conn, req *wsStreamRequest) {
+		switch req.Method {
+		case wsMethodListSubscriptions:
+			c.WriteJSON(map[string]interface{}{"result": []string{"bnbbtc@aggTrade"}, "id": req.ID})
+		default:
+			ackRequest(c, req)
+		}
+		if req.Method == wsMethodSubscribe {
+			for _, name := range req.Params {
+				c.WriteMessage(websocket.TextMessage, []byte(`{"stream":"`+name+`","data":{"e":"aggTrade","s":"BNBBTC","a":1}}`))
+			}
+		}
+	})
+	defer server.Close()
+
+	trades := make(chan *WsAggTradeEvent, 10)
+	m := NewWsStreamManager(func(err error) {})
+	r := s.r()
+	ctx := context.Background()
+	r.Error(m.Subscribe(ctx, WsKlineStream("BNBBTC", KlineInterval("2m"), nil)))
+	r.Empty(m.Streams())
+	cancel, done := s.run(m)
+	r.NoError(m.Subscribe(ctx, WsAggTradeStream("BNBBTC", func(event *WsAggTradeEvent) {
+		trades <- event
+	})))
+	r.Equal(&wsStreamRequest{Method: wsMethodSubscribe, Params: []string{"bnbbtc@aggTrade"}, ID: 1}, <-s.requests)
+	r.Equal("BNBBTC", (<-trades).Symbol)
+	r.Equal([]string{"bnbbtc@aggTrade"}, m.Streams())
+
+	names, err := m.ListSubscriptions(ctx)
+	r.NoError(err)
+	r.Equal([]string{"bnbbtc@aggTrade"}, names)
+	r.Equal(wsMethodListSubscriptions, (<-s.requests).Method)
+
+	r.NoError(m.Unsubscribe(ctx, "bnbbtc@aggTrade", "ltcbtc@depth"))
+	r.Equal(&wsStreamRequest{Method: wsMethodUnsubscribe, Params: []string{"bnbbtc@aggTrade"}, ID: 3}, <-s.requests)
+	r.Empty(m.Streams())
+	r.NoError(m.Unsubscribe(ctx, "bnbbtc@aggTrade"))
+	cancel()
+	r.Equal(context.Canceled, <-done)
+	r.Empty(s.requests)
+}
+
+func (s *websocketStreamManagerTestSuite) TestSubscribeRejected() {
+	server := s.serve(1, nil, func(c *websocket.Conn, req *wsStreamRequest) {
+		c.WriteJSON(map[string]interface{}{
+			"error": map[string]interface{}{"code": 2, "msg": "Invalid request"},
+			"id":    req.ID,
+		})
+		c.WriteJSON(map[string]interface{}{
+			"error": map[string]interface{}{"code": 3, "msg": "Invalid JSON"},
+			"id":    nil,
+		})
+	})
+	defer server.Close()
+
+	errs := make(chan error, 10)
+	m := NewWsStreamManager(func(err error) {
+		errs <- err
+	})
+	r := s.r()
+	cancel, done := s.run(m)
+	err := m.Subscribe(context.Background(), WsDiffDepthStream("BNBBTC", nil))
+	r.Error(err)
+	apiErr, ok := err.(*APIError)
+	r.True(ok)
+	r.Equal(int64(2), apiErr.Code)
+	r.Empty(m.Streams())
+	apiErr, ok = (<-errs).(*APIError)
+	r.True(ok)
+	r.Equal(int64(3), apiErr.Code)
+	cancel()
+	<-done
+}
+
+func (s *websocketStreamManagerTestSuite) TestSubscribeRejectedKeepsOtherHandlers() {
+	server := s.serve(1, nil, func(c *websocket.Conn, req *wsStreamRequest) {
+		c.WriteJSON(map[string]interface{}{
+			"error": map[string]interface{}{"code": 2, "msg": "Invalid request"},
+			"id":    req.ID,
+		})
+	})
+	defer server.Close()
+
+	m := NewWsStreamManager(func(err error) {})
+	r := s.r()
+	cancel, done := s.run(m)
+	m.mu.Lock()
+	other := &wsStreamHandler{}
+	m.handlers["bnbbtc@depth"] = []*wsStreamHandler{other}
+	m.mu.Unlock()
+	err := m.Subscribe(context.Background(), WsDiffDepthStream("BNBBTC", nil), WsAggTradeStream("BNBBTC", nil))
+	_, ok := err.(*APIError)
+	r.True(ok, "%v", err)
+	r.Equal([]string{"bnbbtc@aggTrade"}, (<-s.requests).Params)
+	r.Equal([]string{"bnbbtc@depth"}, m.Streams())
+	r.Equal([]*wsStreamHandler{other}, m.handlers["bnbbtc@depth"])
+
+	err = m.Unsubscribe(context.Background(), "bnbbtc@depth")
+	_, ok = err.(*APIError)
+	r.True(ok, "%v", err)
+	r.Equal([]string{"bnbbtc@depth"}, (<-s.requests).Params)
+	r.Equal([]*wsStreamHandler{other}, m.handlers["bnbbtc@depth"])
+	cancel()
+	<-done
+}
+
+func (s *websocketStreamManagerTestSuite) TestReplay() {
+	server := s.serve(2, []int{2}, ackRequest)
+	defer server.Close()
+
+	m := NewWsStreamManager(func(err error) {})
+	m.Service().ReconnectPolicy(&WsReconnectPolicy{BaseDelay: time.Millisecond})
+	r := s.r()
+	ctx := context.Background()
+	r.NoError(m.Subscribe(ctx, WsAggTradeStream("BNBBTC", nil)))
+	cancel, done := s.run(m)
+	req := <-s.requests
+	r.Equal(wsMethodSubscribe, req.Method)
+	r.Equal([]string{"bnbbtc@aggTrade"}, req.Params)
+	r.NoError(m.Subscribe(ctx, WsDiffDepthStream("LTCBTC", nil)))
+	r.Equal([]string{"ltcbtc@depth"}, (<-s.requests).Params)
+	req = <-s.requests
+	r.Equal(wsMethodSubscribe, req.Method)
+	r.Equal([]string{"bnbbtc@aggTrade", "ltcbtc@depth"}, req.Params)
+	r.EqualValues(2, atomic.LoadInt32(&server.conns))
+	cancel()
+	<-done
+}
+
+func (s *websocketStreamManagerTestSuite) TestRequestLost() {
+	m := NewWsStreamManager(func(err error) {})
+	resp := make(chan *wsStreamResponse, 1)
+	m.pending[1] = resp
+	r := s.r()
+	r.NoError(m.replay(nil))
+	r.Equal(ErrWsDisconnected, (<-resp).err)
+	r.Empty(m.pending)
+}
+
+func (s *websocketStreamManagerTestSuite) TestCloseWhileRequesting() {
+	server := s.serve(1, nil, func(c *websocket.Conn, req *wsStreamRequest) {})
+	defer server.Close()
+
+	m := NewWsStreamManager(func(err error) {})
+	r := s.r()
+	_, done := s.run(m)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- m.Subscribe(context.Background(), WsAggTradeStream("BNBBTC", nil))
+	}()
+	r.Equal(wsMethodSubscribe, (<-s.requests).Method)
+	m.Close()
+	select {
+	case err := <-errs:
+		r.Equal(ErrWsDisconnected, err)
+	case <-time.After(time.Second):
+		r.Fail("request still pending after Close")
+	}
+	r.Equal([]string{"bnbbtc@aggTrade"}, m.Streams())
+	r.Empty(m.pending)
+	<-done
+}
+
+func (s *websocketStreamManagerTestSuite) TestThrottle() {
+	wsMessageInterval = 20 * time.Millisecond
+	m := NewWsStreamManager(nil)
+	r := s.r()
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		r.NoError(m.throttle(context.Background()))
+	}
+	r.True(time.Since(start) >= 40*time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r.Equal(context.Canceled, m.throttle(ctx))
+}
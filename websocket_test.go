@@ -0,0 +1,354 @@
+package binance
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type websocketTestSuite struct {
+	baseTestSuite
+	mu     sync.Mutex
+	states []WsState
+}
+
+func TestWebsocket(t *testing.T) {
+	suite.Run(t, new(websocketTestSuite))
+}
+
+// wsTestServer accept maxConns connections, handled by handle with their
+// number from 1, and refuse the next ones
+type wsTestServer struct {
+	*httptest.Server
+	conns int32
+}
+
+func newWsTestServer(maxConns int, handle func(n int, c *websocket.Conn)) *wsTestServer {
+	s := new(wsTestServer)
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&s.conns, 1))
+		if n > maxConns {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		handle(n, c)
+	}))
+	return s
+}
+
+func (s *wsTestServer) endpoint() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+// waitClose block until the client closes the connection
+func waitClose(c *websocket.Conn) {
+	for {
+		if _, _, err := c.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *websocketTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.states = nil
+}
+
+func (s *websocketTestSuite) newWsService(endpoint string, messages chan string, errs chan error) *WsService {
+	ws := newWsService(endpoint, func(message []byte) {
+		messages <- string(message)
+	}, func(err error) {
+		errs <- err
+	})
+	return ws.ReconnectPolicy(&WsReconnectPolicy{BaseDelay: time.Millisecond}).
+		StateHandler(func(state WsState, err error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.states = append(s.states, state)
+		})
+}
+
+func (s *websocketTestSuite) assertStates(e ...WsState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.r().Equal(e, s.states)
+}
+
+func serveAsync(ws *WsService) chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- ws.Serve()
+	}()
+	return done
+}
+
+func (s *websocketTestSuite) TestReconnect() {
+	server := newWsTestServer(2, func(n int, c *websocket.Conn) {
+		c.WriteMessage(websocket.TextMessage, []byte{byte('a' + n - 1)})
+		if n == 2 {
+			waitClose(c)
+		}
+	})
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs)
+	r := s.r()
+	r.NoError(ws.Connect())
+	done := serveAsync(ws)
+	r.Equal("a", <-messages)
+	r.Equal("b", <-messages)
+	r.Equal(WsStateConnected, ws.State())
+	r.Error(<-errs)
+	ws.Close()
+	r.NoError(<-done)
+	s.assertStates(WsStateConnecting, WsStateConnected, WsStateReconnecting, WsStateConnected, WsStateClosed)
+	r.Equal(ErrWsClosed, ws.Connect())
+}
+
+func (s *websocketTestSuite) TestGiveUp() {
+	server := newWsTestServer(1, func(n int, c *websocket.Conn) {
+		c.WriteMessage(websocket.TextMessage, []byte("a"))
+	})
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs)
+	ws.reconnectPolicy.MaxAttempts = 2
+	r := s.r()
+	r.NoError(ws.Connect())
+	r.Error(<-serveAsync(ws))
+	r.Equal("a", <-messages)
+	r.Len(errs, 1)
+	r.Equal(WsStateClosed, ws.State())
+	s.assertStates(WsStateConnecting, WsStateConnected, WsStateReconnecting, WsStateReconnecting, WsStateClosed)
+	r.EqualValues(3, atomic.LoadInt32(&server.conns))
+}
+
+func (s *websocketTestSuite) TestNoReconnect() {
+	server := newWsTestServer(1, func(n int, c *websocket.Conn) {})
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs).ReconnectPolicy(nil)
+	r := s.r()
+	r.NoError(ws.Connect())
+	err := <-serveAsync(ws)
+	r.Error(err)
+	r.Equal(<-errs, err)
+	s.assertStates(WsStateConnecting, WsStateConnected, WsStateClosed)
+	r.EqualValues(1, atomic.LoadInt32(&server.conns))
+	_, ok := <-ws.Done()
+	r.False(ok)
+}
+
+func (s *websocketTestSuite) TestCloseWhileReconnecting() {
+	server := newWsTestServer(0, nil)
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs)
+	ws.reconnectPolicy.BaseDelay = time.Hour
+	done := serveAsync(ws)
+	for ws.State() != WsStateReconnecting {
+		time.Sleep(time.Millisecond)
+	}
+	ws.Close()
+	<-done
+	s.assertStates(WsStateReconnecting, WsStateClosed)
+	s.r().Empty(errs)
+}
+
+func (s *websocketTestSuite) TestConnectError() {
+	server := newWsTestServer(0, nil)
+	defer server.Close()
+
+	ws := s.newWsService(server.endpoint(), nil, nil)
+	r := s.r()
+	r.Error(ws.Connect())
+	s.assertStates(WsStateConnecting, WsStateClosed)
+	r.Equal("CLOSED", ws.State().String())
+}
+
+func (s *websocketTestSuite) TestAnswerPing() {
+	pongs := make(chan string, 1)
+	server := newWsTestServer(1, func(n int, c *websocket.Conn) {
+		c.SetPongHandler(func(appData string) error {
+			pongs <- appData
+			return nil
+		})
+		c.WriteControl(websocket.PingMessage, []byte("hello"), time.Now().Add(time.Second))
+		waitClose(c)
+	})
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs).PingInterval(0)
+	r := s.r()
+	r.NoError(ws.Connect())
+	done := serveAsync(ws)
+	r.Equal("hello", <-pongs)
+	ws.Close()
+	<-done
+}
+
+func (s *websocketTestSuite) TestKeepalive() {
+	server := newWsTestServer(1, func(n int, c *websocket.Conn) {
+		c.WriteMessage(websocket.TextMessage, []byte("a"))
+		waitClose(c)
+	})
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs).
+		PingInterval(5 * time.Millisecond).ReadTimeout(time.Second)
+	r := s.r()
+	r.True(ws.LastMessageTime().IsZero())
+	r.NoError(ws.Connect())
+	done := serveAsync(ws)
+	r.Equal("a", <-messages)
+	r.WithinDuration(time.Now(), ws.LastMessageTime(), time.Second)
+	for ws.RTT() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	r.True(ws.RTT() < time.Second)
+	ws.Close()
+	<-done
+	r.Empty(errs)
+}
+
+func (s *websocketTestSuite) TestReadTimeout() {
+	server := newWsTestServer(1, func(n int, c *websocket.Conn) {
+		waitClose(c)
+	})
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs).ReconnectPolicy(nil).
+		PingInterval(0).ReadTimeout(20 * time.Millisecond)
+	r := s.r()
+	r.NoError(ws.Connect())
+	<-serveAsync(ws)
+	err := <-errs
+	netErr, ok := err.(interface{ Timeout() bool })
+	r.True(ok, "%v", err)
+	r.True(netErr.Timeout())
+	s.assertStates(WsStateConnecting, WsStateConnected, WsStateClosed)
+}
+
+func (s *websocketTestSuite) TestRun() {
+	server := newWsTestServer(1, func(n int, c *websocket.Conn) {
+		c.WriteMessage(websocket.TextMessage, []byte("a"))
+		waitClose(c)
+	})
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- ws.Run(ctx)
+	}()
+	r := s.r()
+	r.Equal("a", <-messages)
+	cancel()
+	r.Equal(context.Canceled, <-done)
+	<-ws.Done()
+	r.Empty(errs)
+	s.assertStates(WsStateConnecting, WsStateConnected, WsStateClosed)
+	r.Equal(ErrWsClosed, ws.Run(context.Background()))
+}
+
+func (s *websocketTestSuite) TestServeTwice() {
+	server := newWsTestServer(1, func(n int, c *websocket.Conn) {
+		c.WriteMessage(websocket.TextMessage, []byte("a"))
+		waitClose(c)
+	})
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs)
+	r := s.r()
+	r.NoError(ws.Connect())
+	done := serveAsync(ws)
+	r.Equal("a", <-messages)
+	r.Equal(ErrWsServing, ws.Serve())
+	ws.Close()
+	r.NoError(<-done)
+}
+
+func (s *websocketTestSuite) TestRunTwice() {
+	server := newWsTestServer(2, func(n int, c *websocket.Conn) {
+		c.WriteMessage(websocket.TextMessage, []byte("a"))
+		waitClose(c)
+	})
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- ws.Run(ctx)
+	}()
+	r := s.r()
+	r.Equal("a", <-messages)
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	r.Equal(ErrWsServing, ws.Run(ctx2))
+	cancel2()
+	time.Sleep(10 * time.Millisecond)
+	r.Equal(WsStateConnected, ws.State())
+	r.EqualValues(1, atomic.LoadInt32(&server.conns))
+	cancel()
+	r.Equal(context.Canceled, <-done)
+}
+
+func (s *websocketTestSuite) TestRunFirstConnectionFailed() {
+	server := newWsTestServer(0, nil)
+	defer server.Close()
+
+	messages, errs := make(chan string, 10), make(chan error, 10)
+	ws := s.newWsService(server.endpoint(), messages, errs)
+	ws.reconnectPolicy.MaxAttempts = 1
+	r := s.r()
+	r.Error(ws.Run(context.Background()))
+	r.Len(errs, 1)
+	<-ws.Done()
+	s.assertStates(WsStateConnecting, WsStateReconnecting, WsStateClosed)
+	r.EqualValues(2, atomic.LoadInt32(&server.conns))
+}
+
+func (s *websocketTestSuite) TestCloseWithoutServe() {
+	ws := s.newWsService("ws://localhost", nil, nil)
+	ws.Close()
+	_, ok := <-ws.Done()
+	s.r().False(ok)
+	s.assertStates(WsStateClosed)
+}
+
+func (s *websocketTestSuite) TestDefaultErrHandler() {
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+	ws := newWsService("ws://localhost", nil, nil)
+	s.r().NotPanics(func() {
+		ws.errHandler(errWsNotConnected)
+	})
+}
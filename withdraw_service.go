@@ -3,6 +3,8 @@ package binance
 import (
 	"context"
 	"encoding/json"
+	"strconv"
+	"time"
 )
 
 // CreateWithdrawService create withdraw
@@ -94,6 +96,16 @@ func (s *ListWithdrawsService) EndTime(endTime int64) *ListWithdrawsService {
 	return s
 }
 
+// Since set startTime from t
+func (s *ListWithdrawsService) Since(t time.Time) *ListWithdrawsService {
+	return s.StartTime(TimeToMillis(t))
+}
+
+// Until set endTime from t
+func (s *ListWithdrawsService) Until(t time.Time) *ListWithdrawsService {
+	return s.EndTime(TimeToMillis(t))
+}
+
 // Do send request
 func (s *ListWithdrawsService) Do(ctx context.Context) (withdraws []*Withdraw, err error) {
 	r := &request{
@@ -139,4 +151,40 @@ type Withdraw struct {
 	TxID      string  `json:"txId"`
 	ApplyTime int64   `json:"applyTime"`
 	Status    int     `json:"status"`
+	amount    Decimal
+}
+
+// ApplyTimeUTC return apply time as a UTC time
+func (w *Withdraw) ApplyTimeUTC() time.Time {
+	return MillisToTime(w.ApplyTime)
+}
+
+// UnmarshalJSON decode a withdraw keeping the exact amount
+func (w *Withdraw) UnmarshalJSON(data []byte) error {
+	type withdraw Withdraw
+	aux := &struct {
+		*withdraw
+		Amount json.Number `json:"amount"`
+	}{withdraw: (*withdraw)(w)}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	w.Amount, w.amount = 0, Decimal{}
+	if aux.Amount == "" {
+		return nil
+	}
+	var err error
+	if w.Amount, err = aux.Amount.Float64(); err != nil {
+		return err
+	}
+	w.amount, err = ParseDecimal(aux.Amount.String())
+	return err
+}
+
+// AmountDecimal return the exact amount as a decimal
+func (w *Withdraw) AmountDecimal() Decimal {
+	if w.amount.value == nil {
+		return parseDecimalOrZero(strconv.FormatFloat(w.Amount, 'f', -1, 64))
+	}
+	return w.amount
 }
@@ -102,6 +102,7 @@ func (s *withdrawServiceTestSuite) TestListWithdraws() {
 	}
 	s.assertWithdrawEqual(e1, withdraws[0])
 	s.assertWithdrawEqual(e2, withdraws[1])
+	r.Equal("0.005", withdraws[1].AmountDecimal().String())
 }
 
 func (s *withdrawServiceTestSuite) assertWithdrawEqual(e, a *Withdraw) {